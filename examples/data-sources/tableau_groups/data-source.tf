@@ -0,0 +1,10 @@
+# Groups imported from Active Directory
+data "tableau_groups" "imported" {
+  filter = "isLocal:eq:false"
+}
+
+# Local groups named after a team
+data "tableau_groups" "finance" {
+  filter      = "isLocal:eq:true"
+  name_prefix = "Finance - "
+}
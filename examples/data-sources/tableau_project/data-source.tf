@@ -0,0 +1,3 @@
+data "tableau_project" "monthly_reporting" {
+  path = "Finance/Reporting/Monthly"
+}
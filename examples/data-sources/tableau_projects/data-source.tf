@@ -0,0 +1,3 @@
+data "tableau_projects" "finance" {
+  filter = "name:eq:Finance"
+}
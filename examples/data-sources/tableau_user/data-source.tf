@@ -1,3 +1,7 @@
 data "tableau_user" "test_user" {
   email = "test_user@example.com"
 }
+
+data "tableau_user" "test_user_by_name" {
+  name = "tuser"
+}
@@ -0,0 +1,13 @@
+# Creators who haven't signed in since the start of the year
+data "tableau_users" "inactive_creators" {
+  filter = "siteRole:eq:Creator,lastLogin:lt:2024-01-01T00:00:00Z"
+  sort   = "lastLogin:asc"
+}
+
+# Users with the given emails, e.g. to manage their group membership
+data "tableau_users" "analysts" {
+  emails = [
+    "jane.doe@example.com",
+    "john.smith@example.com",
+  ]
+}
@@ -0,0 +1,2 @@
+# Data source can be imported by specifying the data source identifier. The file is published again on the next apply.
+terraform import tableau_datasource.orders 1f2f3e4e-5d6d-7c8c-9b0b-a1a2b3b4c5c6
\ No newline at end of file
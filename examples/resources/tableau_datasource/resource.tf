@@ -0,0 +1,12 @@
+resource "tableau_datasource" "orders" {
+  name        = "Orders"
+  project_id  = tableau_project.finance.id
+  file_path   = "${path.module}/datasources/Orders.tdsx"
+  description = "Orders of the online store"
+  tags        = ["finance"]
+
+  credentials = {
+    username = "tableau_reader"
+    password = var.orders_database_password
+  }
+}
@@ -0,0 +1,9 @@
+resource "tableau_datasource_connection" "orders" {
+  datasource_id  = tableau_datasource.orders.id
+  connection_id  = "8a9b0c1d-2e3f-4a5b-6c7d-8e9f0a1b2c3d"
+  server_address = "orders.db.prod.example.com"
+  server_port    = "5432"
+  username       = "tableau_reader"
+  password       = var.orders_database_password
+  embed_password = true
+}
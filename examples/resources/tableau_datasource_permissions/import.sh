@@ -0,0 +1,2 @@
+# Data source permissions can be imported by specifying the data source identifier.
+terraform import tableau_datasource_permissions.orders 1f2f3e4e-5d6d-7c8c-9b0b-a1a2b3b4c5c6
\ No newline at end of file
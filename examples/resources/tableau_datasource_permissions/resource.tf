@@ -0,0 +1,18 @@
+resource "tableau_group" "finance_analysts" {
+  name = "Finance Analysts"
+}
+
+resource "tableau_datasource_permissions" "orders" {
+  datasource_id = tableau_datasource.orders.id
+
+  permissions = [
+    {
+      group_id = tableau_group.finance_analysts.id
+      capabilities = {
+        Read    = "Allow"
+        Connect = "Allow"
+        Write   = "Deny"
+      }
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Group member can be imported by specifying the group and user identifiers separated by `/`.
+terraform import tableau_group_member.test_group_member de7373bd-ff18-4dab-a579-78e3dcd5ceb4/9f9e9d9c-8b8a-7f7e-6d6c-5b5a4f4e3d3c
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "tableau_group" "test_group" {
+  name = "Test Group"
+}
+
+resource "tableau_user" "test_user" {
+  email        = "test_user@example.com"
+  site_role    = "Unlicensed"
+  auth_setting = "OpenID"
+}
+
+resource "tableau_group_member" "test_group_member" {
+  group_id = tableau_group.test_group.id
+  user_id  = tableau_user.test_user.id
+}
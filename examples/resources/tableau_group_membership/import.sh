@@ -1,2 +1,2 @@
-# Group membership can be imported by specifying the group identifier.
+# Group membership can be imported by specifying the group identifier. Imported members are listed by email in `users`.
 terraform import tableau_group_membership.test_group_membership de7373bd-ff18-4dab-a579-78e3dcd5ceb4
\ No newline at end of file
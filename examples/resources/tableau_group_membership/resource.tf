@@ -14,3 +14,11 @@ resource "tableau_group_membership" "test_group_membership" {
     tableau_user.test_user.email,
   ]
 }
+
+# Members can also be given by user id
+resource "tableau_group_membership" "test_group_membership_by_id" {
+  group_id = tableau_group.test_group.id
+  user_ids = [
+    tableau_user.test_user.id,
+  ]
+}
@@ -0,0 +1,2 @@
+# Project can be imported by specifying the project identifier.
+terraform import tableau_project.finance 1f2f3e4e-5d6d-7c8c-9b0b-a1a2b3b4c5c6
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "tableau_project" "finance" {
+  name                = "Finance"
+  description         = "Finance content"
+  content_permissions = "LockedToProject"
+}
+
+resource "tableau_project" "finance_reporting" {
+  name              = "Reporting"
+  parent_project_id = tableau_project.finance.id
+}
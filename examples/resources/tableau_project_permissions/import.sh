@@ -0,0 +1,3 @@
+# Project permissions can be imported by specifying the project identifier.
+# Default permissions are only managed once configured.
+terraform import tableau_project_permissions.finance 1f2f3e4e-5d6d-7c8c-9b0b-a1a2b3b4c5c6
\ No newline at end of file
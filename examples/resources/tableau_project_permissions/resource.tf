@@ -0,0 +1,33 @@
+resource "tableau_project" "finance" {
+  name                = "Finance"
+  content_permissions = "LockedToProject"
+}
+
+resource "tableau_group" "finance_analysts" {
+  name = "Finance Analysts"
+}
+
+resource "tableau_project_permissions" "finance" {
+  project_id = tableau_project.finance.id
+
+  permissions = [
+    {
+      group_id = tableau_group.finance_analysts.id
+      capabilities = {
+        Read  = "Allow"
+        Write = "Deny"
+      }
+    },
+  ]
+
+  default_workbook_permissions = [
+    {
+      group_id = tableau_group.finance_analysts.id
+      capabilities = {
+        Read         = "Allow"
+        ExportData   = "Allow"
+        WebAuthoring = "Deny"
+      }
+    },
+  ]
+}
@@ -1,2 +1,2 @@
-# User can be imported by specifying the user email with prefix `email/`.
+# User can be imported by specifying the user ID, the user email with prefix `email/` or the username with prefix `name/`.
 terraform import tableau_user.test_user email/test_user@example.com
\ No newline at end of file
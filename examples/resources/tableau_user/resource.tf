@@ -3,3 +3,21 @@ resource "tableau_user" "test_user" {
   site_role    = "Unlicensed"
   auth_setting = "OpenID"
 }
+
+# Username and display name can differ from the email
+resource "tableau_user" "test_user_with_name" {
+  name         = "tuser"
+  full_name    = "Test User"
+  email        = "test_user_with_name@example.com"
+  site_role    = "Unlicensed"
+  auth_setting = "SAML"
+}
+
+# Content owned by the user is transferred to another user when it is deleted
+resource "tableau_user" "test_user_leaving" {
+  email        = "test_user_leaving@example.com"
+  site_role    = "Creator"
+  auth_setting = "SAML"
+
+  transfer_content_to_user_id = tableau_user.test_user_with_name.id
+}
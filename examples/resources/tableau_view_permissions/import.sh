@@ -0,0 +1,2 @@
+# View permissions can be imported by specifying the view identifier.
+terraform import tableau_view_permissions.revenue 9a8b7c6d-5e4f-3a2b-1c0d-e1f2a3b4c5d6
\ No newline at end of file
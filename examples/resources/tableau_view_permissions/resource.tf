@@ -0,0 +1,17 @@
+resource "tableau_group" "finance_analysts" {
+  name = "Finance Analysts"
+}
+
+resource "tableau_view_permissions" "revenue" {
+  view_id = "9a8b7c6d-5e4f-3a2b-1c0d-e1f2a3b4c5d6"
+
+  permissions = [
+    {
+      group_id = tableau_group.finance_analysts.id
+      capabilities = {
+        Read       = "Allow"
+        ExportData = "Deny"
+      }
+    },
+  ]
+}
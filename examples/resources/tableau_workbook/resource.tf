@@ -0,0 +1,8 @@
+resource "tableau_workbook" "sales" {
+  name        = "Sales"
+  project_id  = tableau_project.finance.id
+  file_path   = "${path.module}/workbooks/Sales.twbx"
+  description = "Monthly sales"
+  show_tabs   = true
+  tags        = ["finance", "certified"]
+}
@@ -0,0 +1,2 @@
+# Workbook connection can be imported by specifying the workbook and connection identifiers, separated by a slash.
+terraform import tableau_workbook_connection.sales 1f2f3e4e-5d6d-7c8c-9b0b-a1a2b3b4c5c6/8a9b0c1d-2e3f-4a5b-6c7d-8e9f0a1b2c3d
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Workbook permissions can be imported by specifying the workbook identifier.
+terraform import tableau_workbook_permissions.quarterly_report 1f2f3e4e-5d6d-7c8c-9b0b-a1a2b3b4c5c6
\ No newline at end of file
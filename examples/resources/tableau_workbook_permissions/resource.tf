@@ -0,0 +1,18 @@
+resource "tableau_group" "finance_analysts" {
+  name = "Finance Analysts"
+}
+
+resource "tableau_workbook_permissions" "quarterly_report" {
+  workbook_id = tableau_workbook.quarterly_report.id
+
+  permissions = [
+    {
+      group_id = tableau_group.finance_analysts.id
+      capabilities = {
+        Read         = "Allow"
+        ExportData   = "Allow"
+        WebAuthoring = "Deny"
+      }
+    },
+  ]
+}
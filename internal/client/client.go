@@ -1,20 +1,41 @@
 package client
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
 )
 
+// tokenExpiryMargin is how long before the estimated expiration the session
+// token is renewed, so that in-flight requests do not race the expiry.
+const tokenExpiryMargin = 5 * time.Minute
+
 type TableauClient struct {
 	ApiUrl     string
 	HTTPClient *http.Client
-	AuthToken  string
+	// UploadHTTPClient sends the multipart requests uploading and publishing
+	// files, which take much longer than other requests.
+	UploadHTTPClient *http.Client
+	AuthToken        string
+	RetryPolicy      RetryPolicy
+	// MaxConcurrency bounds the calls run at once by ForEachConcurrently.
+	MaxConcurrency int
+
+	signInUrl   string
+	credentials Credentials
+	tokenExpiry time.Time
+	authMutex   sync.RWMutex
 }
 
 type Site struct {
@@ -42,42 +63,52 @@ type SignInResponse struct {
 	SignInResponseData SignInResponseData `json:"credentials"`
 }
 
-func NewTableauClient(serverAddress string, apiVersion string, site string, personalAccessTokenName string, personalAccessTokenSecret string) (*TableauClient, error) {
+func NewTableauClient(ctx context.Context, serverAddress string, apiVersion string, site string, personalAccessTokenName string, personalAccessTokenSecret string, retryPolicy RetryPolicy, maxConcurrency int, uploadTimeout time.Duration) (*TableauClient, error) {
+	baseUrl := fmt.Sprintf("%s/api/%s", serverAddress, apiVersion)
+
 	tableauClient := &TableauClient{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient:       &http.Client{Timeout: 10 * time.Second},
+		UploadHTTPClient: &http.Client{Timeout: uploadTimeout},
+		RetryPolicy:      retryPolicy,
+		MaxConcurrency:   maxConcurrency,
+		signInUrl:        fmt.Sprintf("%s/auth/signin", baseUrl),
+		credentials: Credentials{
+			TokenName:   personalAccessTokenName,
+			TokenSecret: personalAccessTokenSecret,
+			Site: Site{
+				ContentUrl: site,
+			},
+		},
 	}
 
-	baseUrl := fmt.Sprintf("%s/api/%s", serverAddress, apiVersion)
-	signInUrl := fmt.Sprintf("%s/auth/signin", baseUrl)
-
-	// Create credentials
-	credentials := Credentials{
-		TokenName:   personalAccessTokenName,
-		TokenSecret: personalAccessTokenSecret,
-		Site: Site{
-			ContentUrl: site,
-		},
+	// authenticate
+	signInResponse, err := tableauClient.signIn(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	// Set API URL
+	tableauClient.ApiUrl = fmt.Sprintf("%s/sites/%s", baseUrl, signInResponse.SignInResponseData.Site.ID)
+
+	return tableauClient, nil
+}
+
+// signIn exchanges the personal access token for a new session token and
+// records when that token is estimated to expire. Callers other than
+// NewTableauClient must hold authMutex.
+func (c *TableauClient) signIn(ctx context.Context) (*SignInResponse, error) {
 	// Create sign in request
 	authRequest := SignInRequest{
-		Credentials: credentials,
+		Credentials: c.credentials,
 	}
 
-	// Marshal sign in request to JSON
-	authRequestJson, err := json.Marshal(authRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	// authenticate
-	req, err := http.NewRequest("POST", signInUrl, strings.NewReader(string(authRequestJson)))
+	req, err := newRequest(ctx, "POST", c.signInUrl, authRequest)
 	if err != nil {
 		return nil, err
 	}
 
 	// send request to Tableau API Server
-	body, err := tableauClient.sendRequest(req)
+	body, err := c.doRequest(req, "")
 	if err != nil {
 		return nil, err
 	}
@@ -89,23 +120,227 @@ func NewTableauClient(serverAddress string, apiVersion string, site string, pers
 		return nil, err
 	}
 
-	// Set API URL
-	tableauClient.ApiUrl = fmt.Sprintf("%s/sites/%s", baseUrl, signInResponse.SignInResponseData.Site.ID)
-
 	// Set auth token
-	tableauClient.AuthToken = signInResponse.SignInResponseData.Token
+	c.AuthToken = signInResponse.SignInResponseData.Token
 
-	return tableauClient, nil
+	// Without a parsable expiration the token is only renewed after a 401
+	c.tokenExpiry = time.Time{}
+	lifetime, err := parseTokenLifetime(signInResponse.SignInResponseData.EstimatedTimeToExpiration)
+	if err == nil {
+		c.tokenExpiry = time.Now().Add(lifetime)
+	}
+
+	return &signInResponse, nil
+}
+
+// reauthenticate signs in again, unless another caller has already replaced
+// staleToken in the meantime. This keeps concurrent resource operations that
+// hit an expired session from stampeding the sign in endpoint.
+func (c *TableauClient) reauthenticate(ctx context.Context, staleToken string) error {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+
+	if c.AuthToken != staleToken {
+		return nil
+	}
+
+	_, err := c.signIn(ctx)
+	return err
+}
+
+// currentToken returns the session token, renewing it first when it is about
+// to expire.
+func (c *TableauClient) currentToken(ctx context.Context) (string, error) {
+	c.authMutex.RLock()
+	token, tokenExpiry := c.AuthToken, c.tokenExpiry
+	c.authMutex.RUnlock()
+
+	if tokenExpiry.IsZero() || time.Now().Add(tokenExpiryMargin).Before(tokenExpiry) {
+		return token, nil
+	}
+
+	err := c.reauthenticate(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	c.authMutex.RLock()
+	defer c.authMutex.RUnlock()
+
+	return c.AuthToken, nil
+}
+
+// parseTokenLifetime parses the "hh:mm:ss" estimatedTimeToExpiration returned
+// on sign in, where the hours may exceed 24.
+func parseTokenLifetime(estimatedTimeToExpiration string) (time.Duration, error) {
+	parts := strings.Split(estimatedTimeToExpiration, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid estimatedTimeToExpiration '%s'", estimatedTimeToExpiration)
+	}
+
+	var lifetime time.Duration
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		value, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid estimatedTimeToExpiration '%s': %w", estimatedTimeToExpiration, err)
+		}
+		lifetime += time.Duration(value) * unit
+	}
+
+	return lifetime, nil
 }
 
 func (c *TableauClient) sendRequest(req *http.Request) ([]byte, error) {
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("X-Tableau-Auth", c.AuthToken)
+	ctx := req.Context()
+
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, token)
+	if !IsUnauthorized(err) {
+		return body, err
+	}
+
+	// The session was invalidated before its estimated expiration, sign in
+	// again and replay the request once with the new token
+	err = c.reauthenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err = c.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequest(req, token)
+}
+
+// newRequest builds a request with payload marshalled as its JSON body, or
+// without a body when payload is nil. The body can be recreated through
+// GetBody, so the request can be sent again on retries.
+func newRequest(ctx context.Context, method string, url string, payload any) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		payloadJson, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(payloadJson)
+	}
+
+	return http.NewRequestWithContext(ctx, method, url, body)
+}
+
+// multipartPart is a part of a multipart request body. fileName is only set
+// on parts holding file content.
+type multipartPart struct {
+	name        string
+	fileName    string
+	contentType string
+	content     []byte
+}
+
+// newPayloadPart returns the request_payload part expected by the multipart
+// endpoints, with payload marshalled as JSON, or empty when payload is nil.
+func newPayloadPart(payload any) (multipartPart, error) {
+	if payload == nil {
+		return multipartPart{name: "request_payload", contentType: "text/xml"}, nil
+	}
+
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return multipartPart{}, err
+	}
+
+	return multipartPart{name: "request_payload", contentType: "application/json", content: payloadJson}, nil
+}
+
+// newMultipartRequest builds a request with parts as its multipart/mixed body,
+// as expected by the publish and file upload endpoints. Like newRequest, the
+// body can be recreated through GetBody for retries.
+func newMultipartRequest(ctx context.Context, method string, url string, parts []multipartPart) (*http.Request, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range parts {
+		disposition := fmt.Sprintf(`name="%s"`, quoteEscaper.Replace(part.name))
+		if part.fileName != "" {
+			disposition += fmt.Sprintf(`; filename="%s"`, quoteEscaper.Replace(part.fileName))
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", "form-data; "+disposition)
+		header.Set("Content-Type", part.contentType)
+
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		_, err = partWriter.Write(part.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "multipart/mixed; boundary="+writer.Boundary())
+
+	return req, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// newAttempt returns a copy of req with a fresh body, since the body of a
+// request is consumed when it is sent.
+func newAttempt(req *http.Request) (*http.Request, error) {
+	attempt := req.Clone(req.Context())
+	if req.GetBody == nil {
+		return attempt, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	attempt.Body = body
+
+	return attempt, nil
+}
+
+// doRequest sends the request with the given session token, retrying it
+// according to the retry policy. Failed responses are returned as *APIError.
+func (c *TableauClient) doRequest(req *http.Request, token string) ([]byte, error) {
+	req.Header.Set("Accept", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Tableau-Auth", token)
+	}
+
+	// Multipart bodies carry file content, which is slow to send and process
+	httpClient := c.HTTPClient
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/") && c.UploadHTTPClient != nil {
+		httpClient = c.UploadHTTPClient
+	}
 
 	body, err := retry.DoWithData(
 		func() ([]byte, error) {
-			res, err := c.HTTPClient.Do(req)
+			attempt, err := newAttempt(req)
+			if err != nil {
+				return nil, retry.Unrecoverable(err)
+			}
+
+			res, err := httpClient.Do(attempt)
 			if err != nil {
 				return nil, err
 			}
@@ -115,14 +350,18 @@ func (c *TableauClient) sendRequest(req *http.Request) ([]byte, error) {
 				return nil, err
 			}
 
+			if res.StatusCode == http.StatusUnauthorized {
+				// Retrying with the same token cannot succeed
+				return nil, retry.Unrecoverable(newAPIError(res, body))
+			}
+
 			if (res.StatusCode != http.StatusOK) && (res.StatusCode != 201) && (res.StatusCode != 204) {
-				return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+				return nil, newAPIError(res, body)
 			}
 
 			return body, nil
 		},
-		retry.Attempts(3),
-		retry.Delay(5*time.Second),
+		c.RetryPolicy.options(req)...,
 	)
 
 	if err != nil {
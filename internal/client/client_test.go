@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+const testApiVersion = "3.18"
+
+// testServer fakes the Tableau REST API, answering sign in requests and
+// delegating every other request to handler.
+type testServer struct {
+	*httptest.Server
+
+	mutex   sync.Mutex
+	signIns int
+	bodies  []string
+}
+
+func newTestServer(t *testing.T, handler func(attempt int, w http.ResponseWriter, r *http.Request)) *testServer {
+	t.Helper()
+
+	server := &testServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		if r.URL.Path == fmt.Sprintf("/api/%s/auth/signin", testApiVersion) {
+			server.signIns++
+			fmt.Fprintf(w, `{"credentials":{"site":{"id":"site-id"},"token":"token-%d","estimatedTimeToExpiration":"240:00:00"}}`, server.signIns)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %s", err)
+		}
+		server.bodies = append(server.bodies, string(body))
+
+		handler(len(server.bodies), w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestClient(t *testing.T, server *testServer) *TableauClient {
+	t.Helper()
+
+	retryPolicy := DefaultRetryPolicy()
+	retryPolicy.MinBackoff = 0
+	retryPolicy.MaxBackoff = 0
+
+	c, err := NewTableauClient(context.Background(), server.URL, testApiVersion, "site", "name", "secret", retryPolicy, DefaultMaxConcurrency, DefaultUploadTimeout)
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	return c
+}
+
+func TestCreateUserRetrySendsFullPayload(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"user":{"id":"user-id","email":"user@example.com"}}`)
+	})
+	c := newTestClient(t, server)
+
+	user, err := c.CreateUser(context.Background(), "user@example.com", "user@example.com", "", "Viewer", "SAML")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if user.ID != "user-id" {
+		t.Errorf("expected user ID 'user-id', got '%s'", user.ID)
+	}
+
+	expected, _ := json.Marshal(UserRequest{User: User{Email: "user@example.com", Name: "user@example.com", SiteRole: "Viewer", AuthSetting: "SAML"}})
+	if len(server.bodies) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(server.bodies))
+	}
+	for i, body := range server.bodies {
+		if body != string(expected) {
+			t.Errorf("attempt %d: expected body %s, got %s", i+1, expected, body)
+		}
+	}
+}
+
+func TestUpdateUserRetrySendsFullPayload(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if attempt < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"user":{"id":"user-id","email":"user@example.com"}}`)
+	})
+	c := newTestClient(t, server)
+
+	_, err := c.UpdateUser(context.Background(), "user-id", "user@example.com", "User", "Creator", "SAML")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected, _ := json.Marshal(UserRequest{User: User{Email: "user@example.com", FullName: "User", SiteRole: "Creator", AuthSetting: "SAML"}})
+	if len(server.bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(server.bodies))
+	}
+	for i, body := range server.bodies {
+		if body != string(expected) {
+			t.Errorf("attempt %d: expected body %s, got %s", i+1, expected, body)
+		}
+	}
+}
+
+func TestCreateGroupIsNotRetriedOnServerError(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	c := newTestClient(t, server)
+
+	_, err := c.CreateGroup(context.Background(), "group")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(server.bodies) != 1 {
+		t.Errorf("expected 1 attempt, got %d", len(server.bodies))
+	}
+}
+
+func TestCreateGroupReplayedAfterReauthentication(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tableau-Auth") != "token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"code":"401002","summary":"Unauthorized Access","detail":"Invalid authentication credentials were provided."}}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"group":{"id":"group-id","name":"group"}}`)
+	})
+	c := newTestClient(t, server)
+
+	group, err := c.CreateGroup(context.Background(), "group")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if group.ID != "group-id" {
+		t.Errorf("expected group ID 'group-id', got '%s'", group.ID)
+	}
+
+	expected, _ := json.Marshal(GroupRequest{Group: Group{Name: "group"}})
+	if server.signIns != 2 {
+		t.Errorf("expected 2 sign ins, got %d", server.signIns)
+	}
+	if len(server.bodies) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(server.bodies))
+	}
+	for i, body := range server.bodies {
+		if body != string(expected) {
+			t.Errorf("attempt %d: expected body %s, got %s", i+1, expected, body)
+		}
+	}
+}
+
+func TestUnauthorizedIsNotRetried(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"code":"401002","summary":"Unauthorized Access","detail":"Invalid authentication credentials were provided."}}`)
+	})
+	c := newTestClient(t, server)
+	// Even when configured as retryable, retrying with the same token cannot succeed
+	c.RetryPolicy.RetryableStatusCodes = append(c.RetryPolicy.RetryableStatusCodes, http.StatusUnauthorized)
+
+	_, err := c.GetProject(context.Background(), "project-id")
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+
+	// The request is only replayed once, after signing in again
+	if server.signIns != 2 {
+		t.Errorf("expected 2 sign ins, got %d", server.signIns)
+	}
+	if len(server.bodies) != 2 {
+		t.Errorf("expected 2 attempts, got %d", len(server.bodies))
+	}
+}
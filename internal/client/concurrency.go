@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxConcurrency is how many calls ForEachConcurrently runs at once
+// unless configured otherwise.
+const DefaultMaxConcurrency = 4
+
+// ForEachConcurrently calls fn with every index from 0 to n-1, running at most
+// MaxConcurrency calls at once. It waits for all of them to return and
+// returns the error of each index, nil when the call succeeded, so that the
+// caller can tell which calls made progress. Calls not started before ctx is
+// done fail with the context error.
+func (c *TableauClient) ForEachConcurrently(ctx context.Context, n int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+
+	limit := c.MaxConcurrency
+	if limit < 1 {
+		limit = 1
+	}
+	slots := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case slots <- struct{}{}:
+			if ctx.Err() != nil {
+				<-slots
+				errs[i] = ctx.Err()
+				continue
+			}
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestForEachConcurrentlyLimitsCalls(t *testing.T) {
+	c := &TableauClient{MaxConcurrency: 3}
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	errs := c.ForEachConcurrently(context.Background(), 20, func(ctx context.Context, i int) error {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		if i%5 == 0 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", maxRunning)
+	}
+	if len(errs) != 20 {
+		t.Fatalf("expected 20 errors, got %d", len(errs))
+	}
+	for i, err := range errs {
+		if (i%5 == 0) != (err != nil) {
+			t.Errorf("unexpected error for index %d: %v", i, err)
+		}
+	}
+}
+
+func TestForEachConcurrentlyStopsOnCancel(t *testing.T) {
+	c := &TableauClient{MaxConcurrency: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	errs := c.ForEachConcurrently(ctx, 5, func(ctx context.Context, i int) error {
+		calls++
+		cancel()
+		return nil
+	})
+
+	if calls != 1 {
+		t.Errorf("expected 1 call before cancellation, got %d", calls)
+	}
+	for i, err := range errs[1:] {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected index %d to be cancelled, got %v", i+1, err)
+		}
+	}
+}
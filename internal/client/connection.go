@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Connection is a connection of a workbook or data source to its underlying
+// database. When updating a connection, only the fields set are changed.
+type Connection struct {
+	ID            string    `json:"id,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	ServerAddress string    `json:"serverAddress,omitempty"`
+	ServerPort    string    `json:"serverPort,omitempty"`
+	UserName      string    `json:"userName,omitempty"`
+	Password      string    `json:"password,omitempty"`
+	EmbedPassword *FlexBool `json:"embedPassword,omitempty"`
+}
+
+type ConnectionRequest struct {
+	Connection Connection `json:"connection"`
+}
+
+type ConnectionResponse struct {
+	Connection Connection `json:"connection"`
+}
+
+type ConnectionListResponse struct {
+	Connections []Connection `json:"connection"`
+}
+
+type GetConnectionResponse struct {
+	Connections ConnectionListResponse `json:"connections"`
+}
+
+// getConnection returns the connection of the content at endpoint, such as
+// "/datasources/{id}". The connections of a content are not paginated.
+func (c *TableauClient) getConnection(ctx context.Context, endpoint string, connectionID string) (*Connection, error) {
+	req, err := newRequest(ctx, "GET", fmt.Sprintf("%s%s/connections", c.ApiUrl, endpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetConnectionResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, connection := range resp.Connections.Connections {
+		if connection.ID == connectionID {
+			return &connection, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find connection with id %s: %w", connectionID, ErrNotFound)
+}
+
+// updateConnection changes the fields set in connection on the connection of
+// the content at endpoint.
+func (c *TableauClient) updateConnection(ctx context.Context, endpoint string, connectionID string, connection Connection) (*Connection, error) {
+	connectionRequest := ConnectionRequest{
+		Connection: connection,
+	}
+
+	req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s%s/connections/%s", c.ApiUrl, endpoint, connectionID), connectionRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ConnectionResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Connection, nil
+}
+
+// GetContentConnection returns the connection of a content item of
+// contentType, e.g. ContentTypeWorkbooks.
+func (c *TableauClient) GetContentConnection(ctx context.Context, contentType string, contentID string, connectionID string) (*Connection, error) {
+	return c.getConnection(ctx, fmt.Sprintf("/%s/%s", contentType, contentID), connectionID)
+}
+
+func (c *TableauClient) UpdateContentConnection(ctx context.Context, contentType string, contentID string, connectionID string, connection Connection) (*Connection, error) {
+	return c.updateConnection(ctx, fmt.Sprintf("/%s/%s", contentType, contentID), connectionID, connection)
+}
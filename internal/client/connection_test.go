@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUpdateDatasourceConnectionSendsSetFields(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || !strings.HasSuffix(r.URL.Path, "/datasources/datasource-id/connections/connection-id") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"connection":{"id":"connection-id","serverAddress":"db.example.com","serverPort":"5432","userName":"reader","embedPassword":true}}`)
+	})
+	c := newTestClient(t, server)
+
+	embedPassword := FlexBool(true)
+	connection, err := c.UpdateContentConnection(context.Background(), ContentTypeDatasources, "datasource-id", "connection-id", Connection{
+		UserName:      "reader",
+		Password:      "secret",
+		EmbedPassword: &embedPassword,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"connection":{"userName":"reader","password":"secret","embedPassword":"true"}}`
+	if server.bodies[0] != expected {
+		t.Errorf("expected body %s, got %s", expected, server.bodies[0])
+	}
+	if connection.ServerPort != "5432" || connection.EmbedPassword == nil || !*connection.EmbedPassword {
+		t.Errorf("unexpected connection %+v", connection)
+	}
+}
+
+func TestGetWorkbookConnectionNotFound(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/workbooks/workbook-id/connections") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"connections":{"connection":[{"id":"other-connection-id"}]}}`)
+	})
+	c := newTestClient(t, server)
+
+	_, err := c.GetContentConnection(context.Background(), ContentTypeWorkbooks, "workbook-id", "connection-id")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Content types whose permissions are set on each item, rather than through
+// the default permissions of their project.
+const (
+	ContentTypeWorkbooks   = "workbooks"
+	ContentTypeDatasources = "datasources"
+	ContentTypeViews       = "views"
+)
+
+func (c *TableauClient) contentPermissionsUrl(contentType string, contentID string) string {
+	return fmt.Sprintf("%s/%s/%s/permissions", c.ApiUrl, contentType, contentID)
+}
+
+// GetContentPermissions queries the permissions of a content item of
+// contentType, e.g. ContentTypeWorkbooks.
+func (c *TableauClient) GetContentPermissions(ctx context.Context, contentType string, contentID string) ([]Permission, error) {
+	return c.getPermissions(ctx, c.contentPermissionsUrl(contentType, contentID))
+}
+
+func (c *TableauClient) AddContentPermissions(ctx context.Context, contentType string, contentID string, permissions []Permission) error {
+	return c.addPermissions(ctx, c.contentPermissionsUrl(contentType, contentID), permissions)
+}
+
+func (c *TableauClient) DeleteContentPermission(ctx context.Context, contentType string, contentID string, permission Permission) error {
+	return c.deletePermission(ctx, c.contentPermissionsUrl(contentType, contentID), permission)
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type Datasource struct {
+	ID                    string                 `json:"id,omitempty"`
+	Name                  string                 `json:"name,omitempty"`
+	Description           string                 `json:"description,omitempty"`
+	ContentUrl            string                 `json:"contentUrl,omitempty"`
+	WebpageUrl            string                 `json:"webpageUrl,omitempty"`
+	Type                  string                 `json:"type,omitempty"`
+	Project               *ContentProject        `json:"project,omitempty"`
+	Owner                 *Owner                 `json:"owner,omitempty"`
+	Tags                  *Tags                  `json:"tags,omitempty"`
+	ConnectionCredentials *ConnectionCredentials `json:"connectionCredentials,omitempty"`
+}
+
+// ConnectionCredentials are the credentials published along with a data
+// source or workbook to connect to its underlying database.
+type ConnectionCredentials struct {
+	Name     string   `json:"name"`
+	Password string   `json:"password"`
+	Embed    FlexBool `json:"embed"`
+}
+
+// ProjectID returns the ID of the project of the data source, empty when not
+// returned.
+func (d Datasource) ProjectID() string {
+	if d.Project == nil {
+		return ""
+	}
+	return d.Project.ID
+}
+
+// TagLabels returns the labels of the tags of the data source.
+func (d Datasource) TagLabels() []string {
+	return tagLabels(d.Tags)
+}
+
+// DatasourceUpdate is sent when updating a data source. Unlike Datasource, the
+// description is always sent so that it can be cleared.
+type DatasourceUpdate struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Project     *ContentProject `json:"project,omitempty"`
+}
+
+type DatasourceRequest struct {
+	Datasource Datasource `json:"datasource"`
+}
+
+type DatasourceUpdateRequest struct {
+	Datasource DatasourceUpdate `json:"datasource"`
+}
+
+type DatasourceResponse struct {
+	Datasource Datasource `json:"datasource"`
+}
+
+type DatasourceListResponse struct {
+	Datasources []Datasource `json:"datasource"`
+}
+
+type GetDatasourceResponse struct {
+	Datasources DatasourceListResponse `json:"datasources"`
+	Pagination  Pagination             `json:"pagination"`
+}
+
+func (r GetDatasourceResponse) pageItems() []Datasource {
+	return r.Datasources.Datasources
+}
+
+func (r GetDatasourceResponse) pagination() Pagination {
+	return r.Pagination
+}
+
+// PublishDatasource publishes the .tds, .tdsx or .hyper file at filePath as a
+// data source described by datasource, including its connection credentials
+// when set. With overwrite, a data source with the same name in the same
+// project is replaced instead of failing the publish.
+func (c *TableauClient) PublishDatasource(ctx context.Context, filePath string, datasource Datasource, overwrite bool) (*Datasource, error) {
+	datasourceType := strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), ".")
+	if datasourceType != "tds" && datasourceType != "tdsx" && datasourceType != "hyper" {
+		return nil, fmt.Errorf("unsupported data source file %s, expected a .tds, .tdsx or .hyper file", filePath)
+	}
+
+	query := url.Values{}
+	query.Set("datasourceType", datasourceType)
+	query.Set("overwrite", strconv.FormatBool(overwrite))
+
+	body, err := c.publishFile(ctx, "/datasources", query, DatasourceRequest{Datasource: datasource}, "tableau_datasource", filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := DatasourceResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Datasource, nil
+}
+
+func (c *TableauClient) GetDatasource(ctx context.Context, datasourceID string) (*Datasource, error) {
+	req, err := newRequest(ctx, "GET", fmt.Sprintf("%s/datasources/%s", c.ApiUrl, datasourceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := DatasourceResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Datasource, nil
+}
+
+func (c *TableauClient) UpdateDatasource(ctx context.Context, datasourceID string, name string, description string, projectID string) (*Datasource, error) {
+	datasourceRequest := DatasourceUpdateRequest{
+		Datasource: DatasourceUpdate{
+			Name:        name,
+			Description: description,
+			Project:     &ContentProject{ID: projectID},
+		},
+	}
+
+	req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s/datasources/%s", c.ApiUrl, datasourceID), datasourceRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := DatasourceResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Datasource, nil
+}
+
+func (c *TableauClient) DeleteDatasource(ctx context.Context, datasourceID string) error {
+	req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s/datasources/%s", c.ApiUrl, datasourceID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
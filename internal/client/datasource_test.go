@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPublishDatasourceWithCredentials(t *testing.T) {
+	var server *testServer
+	server = newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/sites/site-id/datasources") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("datasourceType") != "hyper" || r.URL.Query().Get("overwrite") != "false" {
+			t.Errorf("unexpected publish query %s", r.URL.RawQuery)
+		}
+
+		parts := readMultipart(t, r.Header.Get("Content-Type"), server.bodies[attempt-1])
+		expectedCredentials := `"connectionCredentials":{"name":"reader","password":"secret","embed":"true"}`
+		if !strings.Contains(parts["request_payload"], expectedCredentials) {
+			t.Errorf("expected credentials %s in request payload %s", expectedCredentials, parts["request_payload"])
+		}
+		if parts["tableau_datasource"] != "extract content" {
+			t.Errorf("unexpected data source content '%s'", parts["tableau_datasource"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"datasource":{"id":"datasource-id","name":"Orders","type":"hyper","project":{"id":"project-id"}}}`)
+	})
+	c := newTestClient(t, server)
+
+	filePath := filepath.Join(t.TempDir(), "Orders.hyper")
+	err := os.WriteFile(filePath, []byte("extract content"), 0o600)
+	if err != nil {
+		t.Fatalf("writing data source file: %s", err)
+	}
+
+	datasource, err := c.PublishDatasource(context.Background(), filePath, Datasource{
+		Name:                  "Orders",
+		Project:               &ContentProject{ID: "project-id"},
+		ConnectionCredentials: &ConnectionCredentials{Name: "reader", Password: "secret", Embed: true},
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if datasource.ID != "datasource-id" || datasource.ProjectID() != "project-id" || datasource.Type != "hyper" {
+		t.Errorf("unexpected data source %+v", datasource)
+	}
+}
+
+func TestPublishDatasourceInChunksUsesUploadTimeout(t *testing.T) {
+	defaultChunkSize := uploadChunkSize
+	uploadChunkSize = 4
+	t.Cleanup(func() { uploadChunkSize = defaultChunkSize })
+
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/fileUploads"):
+			fmt.Fprint(w, `{"fileUpload":{"uploadSessionId":"upload-id"}}`)
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/fileUploads/upload-id"):
+			// Appending and publishing are slower than the timeout of other requests
+			time.Sleep(200 * time.Millisecond)
+			fmt.Fprint(w, `{"fileUpload":{"uploadSessionId":"upload-id"}}`)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/datasources"):
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"datasource":{"id":"datasource-id","name":"Orders","type":"hyper"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c := newTestClient(t, server)
+	c.HTTPClient.Timeout = 50 * time.Millisecond
+
+	filePath := filepath.Join(t.TempDir(), "Orders.hyper")
+	err := os.WriteFile(filePath, []byte("extract content"), 0o600)
+	if err != nil {
+		t.Fatalf("writing data source file: %s", err)
+	}
+
+	_, err = c.PublishDatasource(context.Background(), filePath, Datasource{Name: "Orders"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// One session, four chunks and the publish, each sent once
+	if len(server.bodies) != 6 {
+		t.Errorf("expected 6 requests, got %d", len(server.bodies))
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrNotFound is returned by lookups that search a list endpoint and find no
+// matching item, as opposed to the server answering with a 404.
+var ErrNotFound = errors.New("not found")
+
+// APIError is a failed response from the Tableau REST API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Summary    string
+	Detail     string
+	Body       string
+	// RetryAfter is the delay requested by the server through the
+	// Retry-After header, zero when absent.
+	RetryAfter time.Duration
+}
+
+type errorEnvelope struct {
+	Error struct {
+		Code    string `json:"code"`
+		Summary string `json:"summary"`
+		Detail  string `json:"detail"`
+	} `json:"error"`
+}
+
+// newAPIError builds an APIError from the error envelope in the response body,
+// keeping the raw body when it is not a Tableau error envelope.
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiError := &APIError{
+		StatusCode: res.StatusCode,
+		Body:       string(body),
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+	}
+
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		apiError.Code = envelope.Error.Code
+		apiError.Summary = envelope.Error.Summary
+		apiError.Detail = envelope.Error.Detail
+	}
+
+	return apiError
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+	}
+
+	return fmt.Sprintf("status: %d, code: %s, summary: %s, detail: %s", e.StatusCode, e.Code, e.Summary, e.Detail)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err means the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is a 409 response, e.g. the object already exists.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is a 401 response.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
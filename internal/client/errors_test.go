@@ -0,0 +1,122 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		header     http.Header
+		body       string
+		expected   APIError
+		message    string
+	}{
+		{
+			name:       "error envelope",
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"409009","summary":"Conflict","detail":"A group with the name 'Finance' already exists."}}`,
+			expected: APIError{
+				StatusCode: http.StatusConflict,
+				Code:       "409009",
+				Summary:    "Conflict",
+				Detail:     "A group with the name 'Finance' already exists.",
+			},
+			message: "status: 409, code: 409009, summary: Conflict, detail: A group with the name 'Finance' already exists.",
+		},
+		{
+			name:       "non JSON body",
+			statusCode: http.StatusBadGateway,
+			body:       "<html><body>Bad Gateway</body></html>",
+			expected:   APIError{StatusCode: http.StatusBadGateway},
+			message:    "status: 502, body: <html><body>Bad Gateway</body></html>",
+		},
+		{
+			name:       "JSON without envelope",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"message":"internal error"}`,
+			expected:   APIError{StatusCode: http.StatusInternalServerError},
+			message:    `status: 500, body: {"message":"internal error"}`,
+		},
+		{
+			name:       "empty body",
+			statusCode: http.StatusServiceUnavailable,
+			expected:   APIError{StatusCode: http.StatusServiceUnavailable},
+			message:    "status: 503, body: ",
+		},
+		{
+			name:       "retry after",
+			statusCode: http.StatusTooManyRequests,
+			header:     http.Header{"Retry-After": []string{"7"}},
+			expected:   APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 7 * time.Second},
+			message:    "status: 429, body: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := test.header
+			if header == nil {
+				header = http.Header{}
+			}
+			apiError := newAPIError(&http.Response{StatusCode: test.statusCode, Header: header}, []byte(test.body))
+
+			test.expected.Body = test.body
+			if *apiError != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, *apiError)
+			}
+			if apiError.Error() != test.message {
+				t.Errorf("expected message '%s', got '%s'", test.message, apiError.Error())
+			}
+		})
+	}
+}
+
+func TestIsConflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "409 response", err: &APIError{StatusCode: http.StatusConflict}, expected: true},
+		{name: "wrapped 409 response", err: fmt.Errorf("creating group: %w", &APIError{StatusCode: http.StatusConflict}), expected: true},
+		{name: "other response", err: &APIError{StatusCode: http.StatusBadRequest}, expected: false},
+		{name: "other error", err: fmt.Errorf("failed"), expected: false},
+		{name: "no error", err: nil, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if IsConflict(test.err) != test.expected {
+				t.Errorf("expected IsConflict to be %t for %v", test.expected, test.err)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "404 response", err: &APIError{StatusCode: http.StatusNotFound}, expected: true},
+		{name: "wrapped 404 response", err: fmt.Errorf("getting user: %w", &APIError{StatusCode: http.StatusNotFound}), expected: true},
+		{name: "lookup without match", err: fmt.Errorf("unable to find user: %w", ErrNotFound), expected: true},
+		{name: "other response", err: &APIError{StatusCode: http.StatusBadRequest}, expected: false},
+		{name: "other error", err: fmt.Errorf("failed"), expected: false},
+		{name: "no error", err: nil, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if IsNotFound(test.err) != test.expected {
+				t.Errorf("expected IsNotFound to be %t for %v", test.expected, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultUploadTimeout is how long requests uploading and publishing files
+// may take unless configured otherwise, including the time Tableau takes to
+// process the published file.
+const DefaultUploadTimeout = 30 * time.Minute
+
+// uploadChunkSize is the size of the chunks appended to file upload sessions.
+// Files up to this size are published in a single request instead.
+var uploadChunkSize = 8 * 1024 * 1024
+
+type FileUpload struct {
+	UploadSessionID string `json:"uploadSessionId"`
+	FileSize        string `json:"fileSize"`
+}
+
+type FileUploadResponse struct {
+	FileUpload FileUpload `json:"fileUpload"`
+}
+
+// initiateFileUpload starts a file upload session and returns its ID.
+func (c *TableauClient) initiateFileUpload(ctx context.Context) (string, error) {
+	req, err := newRequest(ctx, "POST", fmt.Sprintf("%s/fileUploads", c.ApiUrl), nil)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	resp := FileUploadResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.FileUpload.UploadSessionID, nil
+}
+
+// appendToFileUpload appends chunk to the file of an upload session.
+func (c *TableauClient) appendToFileUpload(ctx context.Context, uploadSessionID string, chunk []byte) error {
+	payloadPart, err := newPayloadPart(nil)
+	if err != nil {
+		return err
+	}
+	parts := []multipartPart{
+		payloadPart,
+		{name: "tableau_file", fileName: "file", contentType: "application/octet-stream", content: chunk},
+	}
+
+	req, err := newMultipartRequest(ctx, "PUT", fmt.Sprintf("%s/fileUploads/%s", c.ApiUrl, uploadSessionID), parts)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// uploadFile uploads the content of file chunk by chunk through a new file
+// upload session, and returns the ID of the session.
+func (c *TableauClient) uploadFile(ctx context.Context, file io.Reader) (string, error) {
+	uploadSessionID, err := c.initiateFileUpload(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	chunk := make([]byte, uploadChunkSize)
+	for {
+		n, err := io.ReadFull(file, chunk)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", err
+		}
+
+		err = c.appendToFileUpload(ctx, uploadSessionID, chunk[:n])
+		if err != nil {
+			return "", err
+		}
+
+		if n < uploadChunkSize {
+			break
+		}
+	}
+
+	return uploadSessionID, nil
+}
+
+// publishFile publishes the file at filePath to a publish endpoint such as
+// "/workbooks", along with payload describing the published content. Small
+// files are sent in the part named filePartName of the publish request, larger
+// ones are first uploaded through a file upload session.
+func (c *TableauClient) publishFile(ctx context.Context, endpoint string, query url.Values, payload any, filePartName string, filePath string) ([]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	payloadPart, err := newPayloadPart(payload)
+	if err != nil {
+		return nil, err
+	}
+	parts := []multipartPart{payloadPart}
+
+	if info.Size() <= int64(uploadChunkSize) {
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, multipartPart{
+			name:        filePartName,
+			fileName:    filepath.Base(filePath),
+			contentType: "application/octet-stream",
+			content:     content,
+		})
+	} else {
+		uploadSessionID, err := c.uploadFile(ctx, file)
+		if err != nil {
+			return nil, fmt.Errorf("uploading %s: %w", filePath, err)
+		}
+		query.Set("uploadSessionId", uploadSessionID)
+	}
+
+	req, err := newMultipartRequest(ctx, "POST", fmt.Sprintf("%s%s?%s", c.ApiUrl, endpoint, query.Encode()), parts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendRequest(req)
+}
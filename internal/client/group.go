@@ -1,16 +1,58 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
-	"strings"
 )
 
 type Group struct {
-	ID   string `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+	ID              string       `json:"id,omitempty"`
+	Name            string       `json:"name,omitempty"`
+	Domain          *Domain      `json:"domain,omitempty"`
+	Import          *GroupImport `json:"import,omitempty"`
+	MinimumSiteRole string       `json:"minimumSiteRole,omitempty"`
+	UserCount       json.Number  `json:"userCount,omitempty"`
+}
+
+// GroupImport describes how the members of a group imported from Active
+// Directory are synchronized.
+type GroupImport struct {
+	DomainName       string `json:"domainName,omitempty"`
+	SiteRole         string `json:"siteRole,omitempty"`
+	GrantLicenseMode string `json:"grantLicenseMode,omitempty"`
+}
+
+// localDomainName is the domain of the groups and users managed by Tableau
+// itself.
+const localDomainName = "local"
+
+// DomainName returns the name of the domain of the group, "local" for groups
+// managed by Tableau itself.
+func (g Group) DomainName() string {
+	if g.Domain == nil {
+		return ""
+	}
+	return g.Domain.Name
+}
+
+// IsLocal reports whether the group is managed by Tableau itself, rather than
+// imported from Active Directory or an identity provider.
+func (g Group) IsLocal() bool {
+	return g.DomainName() == "" || g.DomainName() == localDomainName
+}
+
+// SiteRole returns the minimum site role given to the members of the group
+// when they sign in, if any.
+func (g Group) SiteRole() string {
+	if g.MinimumSiteRole != "" {
+		return g.MinimumSiteRole
+	}
+	if g.Import != nil {
+		return g.Import.SiteRole
+	}
+	return ""
 }
 
 type GroupRequest struct {
@@ -30,7 +72,15 @@ type GetGroupResponse struct {
 	Pagination Pagination        `json:"pagination"`
 }
 
-func (c *TableauClient) CreateGroup(name string) (*Group, error) {
+func (r GetGroupResponse) pageItems() []Group {
+	return r.Groups.Groups
+}
+
+func (r GetGroupResponse) pagination() Pagination {
+	return r.Pagination
+}
+
+func (c *TableauClient) CreateGroup(ctx context.Context, name string) (*Group, error) {
 	newGroup := Group{
 		Name: name,
 	}
@@ -38,12 +88,7 @@ func (c *TableauClient) CreateGroup(name string) (*Group, error) {
 		Group: newGroup,
 	}
 
-	payload, err := json.Marshal(groupRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/groups", c.ApiUrl), strings.NewReader(string(payload)))
+	req, err := newRequest(ctx, "POST", fmt.Sprintf("%s/groups", c.ApiUrl), groupRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -62,59 +107,52 @@ func (c *TableauClient) CreateGroup(name string) (*Group, error) {
 	return &resp.Group, nil
 }
 
-func (c *TableauClient) GetGroupByName(groupName string) (*Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups?filter=name:eq:%s", c.ApiUrl, url.QueryEscape(groupName)), nil)
-	if err != nil {
-		return nil, err
+// ListGroups lists the groups matching a Tableau filter expression such as
+// "isLocal:eq:true", or every group when filter is empty. Groups are listed
+// with all their details, including their user count.
+func (c *TableauClient) ListGroups(ctx context.Context, filter string) ([]Group, error) {
+	query := url.Values{}
+	query.Set("fields", "_all_")
+	if filter != "" {
+		query.Set("filter", filter)
 	}
 
-	body, err := c.sendRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	return listAll[Group, GetGroupResponse](ctx, c, fmt.Sprintf("%s/groups?%s", c.ApiUrl, query.Encode()))
+}
 
-	resp := GetGroupResponse{}
-	err = json.Unmarshal(body, &resp)
+func (c *TableauClient) GetGroupByName(ctx context.Context, groupName string) (*Group, error) {
+	groups, err := listAll[Group, GetGroupResponse](ctx, c, fmt.Sprintf("%s/groups?filter=name:eq:%s", c.ApiUrl, url.QueryEscape(groupName)))
 	if err != nil {
 		return nil, err
 	}
 
-	for _, group := range resp.Groups.Groups {
+	for _, group := range groups {
 		if group.Name == groupName {
 			return &group, nil
 		}
 	}
 
-	return nil, fmt.Errorf("unable to find group with name '%s'", groupName)
+	return nil, fmt.Errorf("unable to find group with name '%s': %w", groupName, ErrNotFound)
 }
 
-func (c *TableauClient) GetGroupByID(groupID string) (*Group, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups?pageSize=1000", c.ApiUrl), nil)
-	if err != nil {
-		return nil, err
+func (c *TableauClient) GetGroupByID(ctx context.Context, groupID string) (*Group, error) {
+	// The groups endpoint cannot filter by ID, so walk the pages until found
+	pages := newPaginator[Group, GetGroupResponse](ctx, c, fmt.Sprintf("%s/groups", c.ApiUrl))
+	for pages.Next() {
+		for _, group := range pages.Page() {
+			if group.ID == groupID {
+				return &group, nil
+			}
+		}
 	}
-
-	body, err := c.sendRequest(req)
-	if err != nil {
+	if err := pages.Err(); err != nil {
 		return nil, err
 	}
 
-	resp := GetGroupResponse{}
-	err = json.Unmarshal(body, &resp)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, group := range resp.Groups.Groups {
-		if group.ID == groupID {
-			return &group, nil
-		}
-	}
-
-	return nil, fmt.Errorf("unable to find group with id %s", groupID)
+	return nil, fmt.Errorf("unable to find group with id %s: %w", groupID, ErrNotFound)
 }
 
-func (c *TableauClient) UpdateGroup(groupID string, name string) (*Group, error) {
+func (c *TableauClient) UpdateGroup(ctx context.Context, groupID string, name string) (*Group, error) {
 	updatedGroup := Group{
 		Name: name,
 	}
@@ -122,12 +160,7 @@ func (c *TableauClient) UpdateGroup(groupID string, name string) (*Group, error)
 		Group: updatedGroup,
 	}
 
-	payload, err := json.Marshal(groupRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), strings.NewReader(string(payload)))
+	req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), groupRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -146,8 +179,8 @@ func (c *TableauClient) UpdateGroup(groupID string, name string) (*Group, error)
 	return &resp.Group, nil
 }
 
-func (c *TableauClient) DeleteGroup(groupID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), nil)
+func (c *TableauClient) DeleteGroup(ctx context.Context, groupID string) error {
+	req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s/groups/%s", c.ApiUrl, groupID), nil)
 	if err != nil {
 		return err
 	}
@@ -1,17 +1,11 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
-	"strings"
 )
 
-type GroupMembershipEmailList struct {
-	GroupID    string
-	UserEmails []string
-}
-
 type GroupMembershipRequest struct {
 	User User `json:"user"`
 }
@@ -25,7 +19,15 @@ type GetGroupMembershipResponse struct {
 	Pagination Pagination       `json:"pagination"`
 }
 
-func (c *TableauClient) CreateGroupMembershipByUserID(groupID string, userID string) error {
+func (r GetGroupMembershipResponse) pageItems() []User {
+	return r.Users.Users
+}
+
+func (r GetGroupMembershipResponse) pagination() Pagination {
+	return r.Pagination
+}
+
+func (c *TableauClient) CreateGroupMembershipByUserID(ctx context.Context, groupID string, userID string) error {
 	// Create request object
 	groupMembershipRequest := GroupMembershipRequest{
 		User: User{
@@ -33,14 +35,8 @@ func (c *TableauClient) CreateGroupMembershipByUserID(groupID string, userID str
 		},
 	}
 
-	// Create JSON payload
-	payload, err := json.Marshal(groupMembershipRequest)
-	if err != nil {
-		return err
-	}
-
 	// Create request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID), strings.NewReader(string(payload)))
+	req, err := newRequest(ctx, "POST", fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID), groupMembershipRequest)
 	if err != nil {
 		return err
 	}
@@ -61,15 +57,17 @@ func (c *TableauClient) CreateGroupMembershipByUserID(groupID string, userID str
 	return nil
 }
 
-func (c *TableauClient) CreateGroupMembershipByUserEmail(groupID string, userEmail string) error {
+// CreateGroupMembershipByUserEmail resolves userEmail with users, which may be
+// nil to look the user up on the server instead.
+func (c *TableauClient) CreateGroupMembershipByUserEmail(ctx context.Context, users *UserIndex, groupID string, userEmail string) error {
 	// Get user by email
-	user, err := c.GetUserByEmail(userEmail)
+	user, err := c.resolveUserEmail(ctx, users, userEmail)
 	if err != nil {
 		return err
 	}
 
 	// Create group membership
-	err = c.CreateGroupMembershipByUserID(groupID, user.ID)
+	err = c.CreateGroupMembershipByUserID(ctx, groupID, user.ID)
 	if err != nil {
 		return err
 	}
@@ -77,41 +75,13 @@ func (c *TableauClient) CreateGroupMembershipByUserEmail(groupID string, userEma
 	return nil
 }
 
-func (c *TableauClient) GetGroupMembership(groupID string) (*GroupMembershipEmailList, error) {
-	// Create request
-	// TODO: need to handle pagination
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/groups/%s/users?pageSize=1000", c.ApiUrl, groupID), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.sendRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	resp := GetGroupMembershipResponse{}
-	err = json.Unmarshal(body, &resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var userEmails []string
-	for _, user := range resp.Users.Users {
-		userEmails = append(userEmails, user.Email)
-	}
-
-	groupMembershipEmailList := GroupMembershipEmailList{
-		GroupID:    groupID,
-		UserEmails: userEmails,
-	}
-
-	return &groupMembershipEmailList, nil
+func (c *TableauClient) GetGroupUsers(ctx context.Context, groupID string) ([]User, error) {
+	return listAll[User, GetGroupMembershipResponse](ctx, c, fmt.Sprintf("%s/groups/%s/users", c.ApiUrl, groupID))
 }
 
-func (c *TableauClient) DeleteGroupMembershipByUserID(groupID string, userID string) error {
+func (c *TableauClient) DeleteGroupMembershipByUserID(ctx context.Context, groupID string, userID string) error {
 	// Create delete request
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/groups/%s/users/%s", c.ApiUrl, groupID, userID), nil)
+	req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s/groups/%s/users/%s", c.ApiUrl, groupID, userID), nil)
 	if err != nil {
 		return err
 	}
@@ -125,15 +95,17 @@ func (c *TableauClient) DeleteGroupMembershipByUserID(groupID string, userID str
 	return nil
 }
 
-func (c *TableauClient) DeleteGroupMembershipByUserEmail(groupID string, userEmail string) error {
+// DeleteGroupMembershipByUserEmail resolves userEmail with users, which may be
+// nil to look the user up on the server instead.
+func (c *TableauClient) DeleteGroupMembershipByUserEmail(ctx context.Context, users *UserIndex, groupID string, userEmail string) error {
 	// Get user by email
-	user, err := c.GetUserByEmail(userEmail)
+	user, err := c.resolveUserEmail(ctx, users, userEmail)
 	if err != nil {
 		return err
 	}
 
 	// Delete group membership
-	err = c.DeleteGroupMembershipByUserID(groupID, user.ID)
+	err = c.DeleteGroupMembershipByUserID(ctx, groupID, user.ID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListGroupsDetails(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fields") != "_all_" || r.URL.Query().Get("filter") != "isLocal:eq:false" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"pagination":{"totalAvailable":"3"},"groups":{"group":[
+			{"id":"all-users","name":"All Users","domain":{"name":"local"},"userCount":"12"},
+			{"id":"creators","name":"Creators","minimumSiteRole":"Creator","userCount":3},
+			{"id":"ad","name":"AD Group","domain":{"name":"example.com"},"import":{"domainName":"example.com","siteRole":"Viewer","grantLicenseMode":"onSync"}}
+		]}}`)
+	})
+	c := newTestClient(t, server)
+
+	groups, err := c.ListGroups(context.Background(), "isLocal:eq:false")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(groups))
+	}
+
+	for _, test := range []struct {
+		group     Group
+		isLocal   bool
+		siteRole  string
+		userCount string
+	}{
+		{groups[0], true, "", "12"},
+		{groups[1], true, "Creator", "3"},
+		{groups[2], false, "Viewer", ""},
+	} {
+		if test.group.IsLocal() != test.isLocal {
+			t.Errorf("%s: expected local %t", test.group.Name, test.isLocal)
+		}
+		if test.group.SiteRole() != test.siteRole {
+			t.Errorf("%s: expected site role '%s', got '%s'", test.group.Name, test.siteRole, test.group.SiteRole())
+		}
+		if test.group.UserCount.String() != test.userCount {
+			t.Errorf("%s: expected user count '%s', got '%s'", test.group.Name, test.userCount, test.group.UserCount)
+		}
+	}
+}
+
+func TestCreateGroupConflict(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"error":{"code":"409009","summary":"Conflict","detail":"A group with the name 'Analysts' already exists."}}`)
+	})
+	c := newTestClient(t, server)
+
+	_, err := c.CreateGroup(context.Background(), "Analysts")
+	if !IsConflict(err) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+	if len(server.bodies) != 1 {
+		t.Errorf("expected conflicts not to be retried, got %d attempts", len(server.bodies))
+	}
+}
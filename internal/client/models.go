@@ -1,7 +1,70 @@
 package client
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
 type Pagination struct {
 	PageNumber     string `json:"pageNumber"`
 	PageSize       string `json:"pageSize"`
 	TotalAvailable string `json:"totalAvailable"`
 }
+
+func (p Pagination) totalAvailable() (int, error) {
+	if p.TotalAvailable == "" {
+		return 0, nil
+	}
+
+	total, err := strconv.Atoi(p.TotalAvailable)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pagination totalAvailable '%s': %w", p.TotalAvailable, err)
+	}
+
+	return total, nil
+}
+
+type Owner struct {
+	ID string `json:"id,omitempty"`
+}
+
+// ContentProject is the project that a workbook or data source belongs to.
+type ContentProject struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// FlexBool is a boolean sent as a "true" or "false" string, like the XML
+// attributes it stands for in the Tableau REST API, and read from either a
+// string or a JSON boolean.
+type FlexBool bool
+
+func (b FlexBool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatBool(bool(b)))
+}
+
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	var value any
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case bool:
+		*b = FlexBool(value)
+	case string:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean '%s': %w", value, err)
+		}
+		*b = FlexBool(parsed)
+	case nil:
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// pageSize is the largest page size accepted by the Tableau REST API.
+const pageSize = 1000
+
+// pagedResponse is implemented by the list response envelopes so that a
+// paginator can extract the items and the pagination block of every page.
+type pagedResponse[T any] interface {
+	pageItems() []T
+	pagination() Pagination
+}
+
+// paginator walks a list endpoint page by page until every item reported by
+// Pagination.TotalAvailable has been returned.
+type paginator[T any, R pagedResponse[T]] struct {
+	ctx        context.Context
+	client     *TableauClient
+	endpoint   string
+	pageNumber int
+	fetched    int
+	done       bool
+	page       []T
+	err        error
+}
+
+func newPaginator[T any, R pagedResponse[T]](ctx context.Context, c *TableauClient, endpoint string) *paginator[T, R] {
+	return &paginator[T, R]{
+		ctx:      ctx,
+		client:   c,
+		endpoint: endpoint,
+	}
+}
+
+// Next fetches the next page and reports whether it contains any items.
+func (p *paginator[T, R]) Next() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	pageUrl, err := url.Parse(p.endpoint)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.pageNumber++
+	query := pageUrl.Query()
+	query.Set("pageSize", strconv.Itoa(pageSize))
+	query.Set("pageNumber", strconv.Itoa(p.pageNumber))
+	pageUrl.RawQuery = query.Encode()
+
+	req, err := newRequest(p.ctx, "GET", pageUrl.String(), nil)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	body, err := p.client.sendRequest(req)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	var resp R
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	totalAvailable, err := resp.pagination().totalAvailable()
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.page = resp.pageItems()
+	p.fetched += len(p.page)
+	if len(p.page) == 0 || p.fetched >= totalAvailable {
+		p.done = true
+	}
+
+	return len(p.page) > 0
+}
+
+// Page returns the items of the page fetched by the last call to Next.
+func (p *paginator[T, R]) Page() []T {
+	return p.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *paginator[T, R]) Err() error {
+	return p.err
+}
+
+// listAll collects the items of every page of a list endpoint.
+func listAll[T any, R pagedResponse[T]](ctx context.Context, c *TableauClient, endpoint string) ([]T, error) {
+	var items []T
+
+	pages := newPaginator[T, R](ctx, c, endpoint)
+	for pages.Next() {
+		items = append(items, pages.Page()...)
+	}
+	if err := pages.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// countAll returns the number of items of a list endpoint, fetching a single
+// item instead of every page.
+func (c *TableauClient) countAll(ctx context.Context, endpoint string) (int, error) {
+	countUrl, err := url.Parse(endpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	query := countUrl.Query()
+	query.Set("pageSize", "1")
+	query.Set("pageNumber", "1")
+	countUrl.RawQuery = query.Encode()
+
+	req, err := newRequest(ctx, "GET", countUrl.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return 0, err
+	}
+
+	resp := struct {
+		Pagination Pagination `json:"pagination"`
+	}{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Pagination.totalAvailable()
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GranteeTypeUser  = "user"
+	GranteeTypeGroup = "group"
+)
+
+// Permission is a single capability allowed or denied to a user or group.
+type Permission struct {
+	GranteeType string
+	GranteeID   string
+	Capability  string
+	Mode        string
+}
+
+type Grantee struct {
+	ID string `json:"id"`
+}
+
+type Capability struct {
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+}
+
+type CapabilityList struct {
+	Capabilities []Capability `json:"capability"`
+}
+
+type GranteeCapabilities struct {
+	User         *Grantee       `json:"user,omitempty"`
+	Group        *Grantee       `json:"group,omitempty"`
+	Capabilities CapabilityList `json:"capabilities"`
+}
+
+type Permissions struct {
+	GranteeCapabilities []GranteeCapabilities `json:"granteeCapabilities"`
+}
+
+type PermissionsRequest struct {
+	Permissions Permissions `json:"permissions"`
+}
+
+type PermissionsResponse struct {
+	Permissions Permissions `json:"permissions"`
+}
+
+// flattenPermissions converts the grantee capabilities returned by Tableau
+// to one Permission per capability.
+func flattenPermissions(permissions Permissions) []Permission {
+	var flattened []Permission
+	for _, granteeCapabilities := range permissions.GranteeCapabilities {
+		granteeType, grantee := GranteeTypeGroup, granteeCapabilities.Group
+		if granteeCapabilities.User != nil {
+			granteeType, grantee = GranteeTypeUser, granteeCapabilities.User
+		}
+		if grantee == nil {
+			continue
+		}
+
+		for _, capability := range granteeCapabilities.Capabilities.Capabilities {
+			flattened = append(flattened, Permission{
+				GranteeType: granteeType,
+				GranteeID:   grantee.ID,
+				Capability:  capability.Name,
+				Mode:        capability.Mode,
+			})
+		}
+	}
+
+	return flattened
+}
+
+// groupPermissions converts permissions to the grantee capabilities sent to
+// Tableau, with one entry per grantee.
+func groupPermissions(permissions []Permission) Permissions {
+	grouped := Permissions{
+		GranteeCapabilities: []GranteeCapabilities{},
+	}
+	indexes := map[Permission]int{}
+	for _, permission := range permissions {
+		key := Permission{GranteeType: permission.GranteeType, GranteeID: permission.GranteeID}
+		index, ok := indexes[key]
+		if !ok {
+			granteeCapabilities := GranteeCapabilities{}
+			if permission.GranteeType == GranteeTypeUser {
+				granteeCapabilities.User = &Grantee{ID: permission.GranteeID}
+			} else {
+				granteeCapabilities.Group = &Grantee{ID: permission.GranteeID}
+			}
+			grouped.GranteeCapabilities = append(grouped.GranteeCapabilities, granteeCapabilities)
+			index = len(grouped.GranteeCapabilities) - 1
+			indexes[key] = index
+		}
+
+		capabilities := &grouped.GranteeCapabilities[index].Capabilities
+		capabilities.Capabilities = append(capabilities.Capabilities, Capability{
+			Name: permission.Capability,
+			Mode: permission.Mode,
+		})
+	}
+
+	return grouped
+}
+
+// getPermissions queries the permissions at a permissions endpoint, such as
+// the permissions or default permissions of a project.
+func (c *TableauClient) getPermissions(ctx context.Context, endpoint string) ([]Permission, error) {
+	req, err := newRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := PermissionsResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenPermissions(resp.Permissions), nil
+}
+
+// addPermissions adds permissions at a permissions endpoint, leaving any
+// other permission in place.
+func (c *TableauClient) addPermissions(ctx context.Context, endpoint string, permissions []Permission) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	permissionsRequest := PermissionsRequest{
+		Permissions: groupPermissions(permissions),
+	}
+
+	req, err := newRequest(ctx, "PUT", endpoint, permissionsRequest)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deletePermission removes a single capability at a permissions endpoint.
+func (c *TableauClient) deletePermission(ctx context.Context, endpoint string, permission Permission) error {
+	req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s/%ss/%s/%s/%s", endpoint, permission.GranteeType, permission.GranteeID, permission.Capability, permission.Mode), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DiffPermissions returns the permissions of desired missing from current,
+// and the permissions of current missing from desired.
+func DiffPermissions(current []Permission, desired []Permission) (toAdd []Permission, toDelete []Permission) {
+	currentSet := map[Permission]bool{}
+	for _, permission := range current {
+		currentSet[permission] = true
+	}
+	desiredSet := map[Permission]bool{}
+	for _, permission := range desired {
+		desiredSet[permission] = true
+	}
+
+	for _, permission := range desired {
+		if !currentSet[permission] {
+			toAdd = append(toAdd, permission)
+		}
+	}
+	for _, permission := range current {
+		if !desiredSet[permission] {
+			toDelete = append(toDelete, permission)
+		}
+	}
+
+	return toAdd, toDelete
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffPermissions(t *testing.T) {
+	read := Permission{GranteeType: GranteeTypeGroup, GranteeID: "group", Capability: "Read", Mode: "Allow"}
+	writeAllowed := Permission{GranteeType: GranteeTypeUser, GranteeID: "user", Capability: "Write", Mode: "Allow"}
+	writeDenied := Permission{GranteeType: GranteeTypeUser, GranteeID: "user", Capability: "Write", Mode: "Deny"}
+
+	toAdd, toDelete := DiffPermissions(
+		[]Permission{read, writeAllowed},
+		[]Permission{read, writeDenied},
+	)
+
+	if !reflect.DeepEqual(toAdd, []Permission{writeDenied}) {
+		t.Errorf("expected to add %v, got %v", []Permission{writeDenied}, toAdd)
+	}
+	if !reflect.DeepEqual(toDelete, []Permission{writeAllowed}) {
+		t.Errorf("expected to delete %v, got %v", []Permission{writeAllowed}, toDelete)
+	}
+}
+
+func TestGroupPermissionsRoundTrip(t *testing.T) {
+	permissions := []Permission{
+		{GranteeType: GranteeTypeGroup, GranteeID: "group", Capability: "Read", Mode: "Allow"},
+		{GranteeType: GranteeTypeUser, GranteeID: "user", Capability: "Write", Mode: "Deny"},
+		{GranteeType: GranteeTypeGroup, GranteeID: "group", Capability: "ProjectLeader", Mode: "Allow"},
+	}
+
+	grouped := groupPermissions(permissions)
+	if len(grouped.GranteeCapabilities) != 2 {
+		t.Fatalf("expected 2 grantees, got %d", len(grouped.GranteeCapabilities))
+	}
+
+	toAdd, toDelete := DiffPermissions(permissions, flattenPermissions(grouped))
+	if len(toAdd) != 0 || len(toDelete) != 0 {
+		t.Errorf("expected round trip to preserve permissions, got %v to add and %v to delete", toAdd, toDelete)
+	}
+}
+
+func TestContentPermissionsEndpoints(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/sites/site-id/views/view-id/permissions"):
+			fmt.Fprint(w, `{"permissions":{"granteeCapabilities":[{"group":{"id":"group-id"},"capabilities":{"capability":[{"name":"Read","mode":"Allow"}]}}]}}`)
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/sites/site-id/views/view-id/permissions/groups/group-id/Read/Allow"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c := newTestClient(t, server)
+
+	permissions, err := c.GetContentPermissions(context.Background(), ContentTypeViews, "view-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []Permission{{GranteeType: GranteeTypeGroup, GranteeID: "group-id", Capability: "Read", Mode: "Allow"}}
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Fatalf("expected permissions %v, got %v", expected, permissions)
+	}
+
+	err = c.DeleteContentPermission(context.Background(), ContentTypeViews, "view-id", permissions[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Project struct {
+	ID                 string `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	Description        string `json:"description,omitempty"`
+	ParentProjectID    string `json:"parentProjectId,omitempty"`
+	ContentPermissions string `json:"contentPermissions,omitempty"`
+	Owner              *Owner `json:"owner,omitempty"`
+}
+
+// OwnerID returns the ID of the project owner, empty when not returned.
+func (p Project) OwnerID() string {
+	if p.Owner == nil {
+		return ""
+	}
+	return p.Owner.ID
+}
+
+// ProjectUpdate is sent when updating a project. Unlike Project, the
+// description and parent are always sent so that they can be cleared, which
+// moves the project to the top level.
+type ProjectUpdate struct {
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	ParentProjectID    string `json:"parentProjectId"`
+	ContentPermissions string `json:"contentPermissions,omitempty"`
+	Owner              *Owner `json:"owner,omitempty"`
+}
+
+type ProjectRequest struct {
+	Project Project `json:"project"`
+}
+
+type ProjectUpdateRequest struct {
+	Project ProjectUpdate `json:"project"`
+}
+
+type ProjectResponse struct {
+	Project Project `json:"project"`
+}
+
+type ProjectListResponse struct {
+	Projects []Project `json:"project"`
+}
+
+type GetProjectResponse struct {
+	Projects   ProjectListResponse `json:"projects"`
+	Pagination Pagination          `json:"pagination"`
+}
+
+func (r GetProjectResponse) pageItems() []Project {
+	return r.Projects.Projects
+}
+
+func (r GetProjectResponse) pagination() Pagination {
+	return r.Pagination
+}
+
+// CreateProject creates a project owned by ownerID, or by the signed in user
+// when empty. When the project was created but changing its owner failed, the
+// created project is returned along with the error, so that the caller can
+// keep track of it.
+func (c *TableauClient) CreateProject(ctx context.Context, name string, description string, parentProjectID string, contentPermissions string, ownerID string) (*Project, error) {
+	newProject := Project{
+		Name:               name,
+		Description:        description,
+		ParentProjectID:    parentProjectID,
+		ContentPermissions: contentPermissions,
+	}
+	projectRequest := ProjectRequest{
+		Project: newProject,
+	}
+
+	req, err := newRequest(ctx, "POST", fmt.Sprintf("%s/projects", c.ApiUrl), projectRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ProjectResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Projects are owned by the signed in user when created, so any other
+	// owner has to be set afterwards
+	if ownerID != "" && ownerID != resp.Project.OwnerID() {
+		project, err := c.UpdateProject(ctx, resp.Project.ID, name, description, parentProjectID, contentPermissions, ownerID)
+		if err != nil {
+			return &resp.Project, fmt.Errorf("changing owner of project %s: %w", resp.Project.ID, err)
+		}
+		return project, nil
+	}
+
+	return &resp.Project, nil
+}
+
+// ListProjects lists the projects matching a Tableau filter expression such
+// as "name:eq:Finance", or every project when filter is empty.
+func (c *TableauClient) ListProjects(ctx context.Context, filter string) ([]Project, error) {
+	endpoint := fmt.Sprintf("%s/projects", c.ApiUrl)
+	if filter != "" {
+		endpoint = fmt.Sprintf("%s?filter=%s", endpoint, url.QueryEscape(filter))
+	}
+
+	return listAll[Project, GetProjectResponse](ctx, c, endpoint)
+}
+
+func (c *TableauClient) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	// The projects endpoint cannot filter by ID, so walk the pages until found
+	pages := newPaginator[Project, GetProjectResponse](ctx, c, fmt.Sprintf("%s/projects", c.ApiUrl))
+	for pages.Next() {
+		for _, project := range pages.Page() {
+			if project.ID == projectID {
+				return &project, nil
+			}
+		}
+	}
+	if err := pages.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("unable to find project with id %s: %w", projectID, ErrNotFound)
+}
+
+func (c *TableauClient) UpdateProject(ctx context.Context, projectID string, name string, description string, parentProjectID string, contentPermissions string, ownerID string) (*Project, error) {
+	updatedProject := ProjectUpdate{
+		Name:               name,
+		Description:        description,
+		ParentProjectID:    parentProjectID,
+		ContentPermissions: contentPermissions,
+	}
+	if ownerID != "" {
+		updatedProject.Owner = &Owner{ID: ownerID}
+	}
+	projectRequest := ProjectUpdateRequest{
+		Project: updatedProject,
+	}
+
+	req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s/projects/%s", c.ApiUrl, projectID), projectRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ProjectResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Project, nil
+}
+
+func (c *TableauClient) DeleteProject(ctx context.Context, projectID string) error {
+	req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s/projects/%s", c.ApiUrl, projectID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ProjectPaths maps the ID of every project to its path, the names of its
+// ancestors and itself joined by "/". Ancestors missing from projects end the
+// path early.
+func ProjectPaths(projects []Project) map[string]string {
+	projectsByID := make(map[string]Project, len(projects))
+	for _, project := range projects {
+		projectsByID[project.ID] = project
+	}
+
+	paths := make(map[string]string, len(projects))
+	for _, project := range projects {
+		names := []string{project.Name}
+		visited := map[string]bool{project.ID: true}
+		for parentID := project.ParentProjectID; parentID != "" && !visited[parentID]; {
+			parent, ok := projectsByID[parentID]
+			if !ok {
+				break
+			}
+			names = append([]string{parent.Name}, names...)
+			visited[parentID] = true
+			parentID = parent.ParentProjectID
+		}
+		paths[project.ID] = strings.Join(names, "/")
+	}
+
+	return paths
+}
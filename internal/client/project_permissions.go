@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Content types of the default permissions of a project.
+const (
+	DefaultPermissionsWorkbooks   = "workbooks"
+	DefaultPermissionsDatasources = "datasources"
+	DefaultPermissionsFlows       = "flows"
+	DefaultPermissionsMetrics     = "metrics"
+)
+
+func (c *TableauClient) projectPermissionsUrl(projectID string) string {
+	return fmt.Sprintf("%s/projects/%s/permissions", c.ApiUrl, projectID)
+}
+
+func (c *TableauClient) projectDefaultPermissionsUrl(projectID string, contentType string) string {
+	return fmt.Sprintf("%s/projects/%s/default-permissions/%s", c.ApiUrl, projectID, contentType)
+}
+
+func (c *TableauClient) GetProjectPermissions(ctx context.Context, projectID string) ([]Permission, error) {
+	return c.getPermissions(ctx, c.projectPermissionsUrl(projectID))
+}
+
+func (c *TableauClient) AddProjectPermissions(ctx context.Context, projectID string, permissions []Permission) error {
+	return c.addPermissions(ctx, c.projectPermissionsUrl(projectID), permissions)
+}
+
+func (c *TableauClient) DeleteProjectPermission(ctx context.Context, projectID string, permission Permission) error {
+	return c.deletePermission(ctx, c.projectPermissionsUrl(projectID), permission)
+}
+
+// GetProjectDefaultPermissions queries the permissions given to content of
+// contentType published to the project, e.g. DefaultPermissionsWorkbooks.
+func (c *TableauClient) GetProjectDefaultPermissions(ctx context.Context, projectID string, contentType string) ([]Permission, error) {
+	return c.getPermissions(ctx, c.projectDefaultPermissionsUrl(projectID, contentType))
+}
+
+func (c *TableauClient) AddProjectDefaultPermissions(ctx context.Context, projectID string, contentType string, permissions []Permission) error {
+	return c.addPermissions(ctx, c.projectDefaultPermissionsUrl(projectID, contentType), permissions)
+}
+
+func (c *TableauClient) DeleteProjectDefaultPermission(ctx context.Context, projectID string, contentType string, permission Permission) error {
+	return c.deletePermission(ctx, c.projectDefaultPermissionsUrl(projectID, contentType), permission)
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjectPaths(t *testing.T) {
+	projects := []Project{
+		{ID: "monthly", Name: "Monthly", ParentProjectID: "reporting"},
+		{ID: "finance", Name: "Finance"},
+		{ID: "reporting", Name: "Reporting", ParentProjectID: "finance"},
+		{ID: "orphan", Name: "Orphan", ParentProjectID: "hidden"},
+	}
+
+	expected := map[string]string{
+		"finance":   "Finance",
+		"reporting": "Finance/Reporting",
+		"monthly":   "Finance/Reporting/Monthly",
+		"orphan":    "Orphan",
+	}
+
+	paths := ProjectPaths(projects)
+	for id, expectedPath := range expected {
+		if paths[id] != expectedPath {
+			t.Errorf("project %s: expected path '%s', got '%s'", id, expectedPath, paths[id])
+		}
+	}
+}
+
+func TestCreateProjectReturnsProjectWhenChangingOwnerFails(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"project":{"id":"project-id","name":"Finance","owner":{"id":"signed-in-user-id"}}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"code":"404002","summary":"User Not Found","detail":"The user 'owner-id' could not be found."}}`)
+	})
+	c := newTestClient(t, server)
+
+	project, err := c.CreateProject(context.Background(), "Finance", "", "", "ManagedByOwner", "owner-id")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if project == nil || project.ID != "project-id" {
+		t.Fatalf("expected the created project along with the error, got %+v", project)
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+)
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts uint
+	// MinBackoff is the delay before the first retry, doubled on every
+	// subsequent retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential backoff.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the response status codes worth retrying.
+	RetryableStatusCodes []int
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+func (p RetryPolicy) options(req *http.Request) []retry.Option {
+	return []retry.Option{
+		retry.Attempts(p.MaxAttempts),
+		retry.RetryIf(func(err error) bool {
+			return p.isRetryable(req, err)
+		}),
+		retry.DelayType(func(n uint, err error, _ *retry.Config) time.Duration {
+			return p.delay(n, err)
+		}),
+		retry.Context(req.Context()),
+		retry.LastErrorOnly(true),
+	}
+}
+
+// isRetryable reports whether req, which failed with err, may be sent again.
+func (p RetryPolicy) isRetryable(req *http.Request, err error) bool {
+	// RetryIf replaces the default check, so errors marked unrecoverable must
+	// be checked here
+	if !retry.IsRecoverable(err) {
+		return false
+	}
+
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		// The request may or may not have reached the server, so only
+		// replay it when doing so twice has no additional effect
+		return isIdempotent(req)
+	}
+
+	if !isIdempotent(req) && apiError.StatusCode != http.StatusTooManyRequests {
+		// A rate limited request was rejected before being processed, any
+		// other failure may have been applied partially
+		return false
+	}
+
+	for _, statusCode := range p.RetryableStatusCodes {
+		if apiError.StatusCode == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// delay returns how long to wait before retry n, honoring the Retry-After
+// header and otherwise backing off exponentially with full jitter.
+func (p RetryPolicy) delay(n uint, err error) time.Duration {
+	var apiError *APIError
+	if errors.As(err, &apiError) && apiError.RetryAfter > 0 {
+		return apiError.RetryAfter
+	}
+
+	// Without a minimum there is nothing to grow, the retries are immediate
+	if p.MinBackoff <= 0 {
+		return 0
+	}
+
+	backoff := p.MaxBackoff
+	if n < 32 && p.MinBackoff<<n > 0 && p.MinBackoff<<n < p.MaxBackoff {
+		backoff = p.MinBackoff << n
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+func isIdempotent(req *http.Request) bool {
+	// Appending to a file upload session is a PUT, yet sending it twice
+	// appends the chunk twice
+	if req.Method == http.MethodPut && strings.Contains(req.URL.Path, "/fileUploads/") {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second}
+
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		n      uint
+		err    error
+		max    time.Duration
+	}{
+		{name: "first retry", policy: policy, n: 0, err: errors.New("failed"), max: time.Second},
+		{name: "grows exponentially", policy: policy, n: 3, err: errors.New("failed"), max: 8 * time.Second},
+		{name: "capped", policy: policy, n: 10, err: errors.New("failed"), max: 30 * time.Second},
+		{name: "capped on overflow", policy: policy, n: 63, err: errors.New("failed"), max: 30 * time.Second},
+		{name: "no minimum", policy: RetryPolicy{MinBackoff: 0, MaxBackoff: 30 * time.Second}, n: 3, err: errors.New("failed"), max: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The delay is random, so check that it stays within its bound and
+			// that the bound is reached, at least halfway
+			var longest time.Duration
+			for i := 0; i < 100; i++ {
+				delay := test.policy.delay(test.n, test.err)
+				if delay < 0 || delay > test.max {
+					t.Fatalf("expected a delay between 0 and %s, got %s", test.max, delay)
+				}
+				if delay > longest {
+					longest = delay
+				}
+			}
+			if longest < test.max/2 {
+				t.Errorf("expected delays up to %s, got at most %s", test.max, longest)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelayHonorsRetryAfter(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 45 * time.Second}
+
+	for _, policy := range []RetryPolicy{
+		{MinBackoff: time.Second, MaxBackoff: 30 * time.Second},
+		{MinBackoff: 0, MaxBackoff: 30 * time.Second},
+	} {
+		delay := policy.delay(0, err)
+		if delay != 45*time.Second {
+			t.Errorf("expected the Retry-After delay of 45s to take precedence over %+v, got %s", policy, delay)
+		}
+	}
+}
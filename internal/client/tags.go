@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type Tag struct {
+	Label string `json:"label"`
+}
+
+type Tags struct {
+	Tags []Tag `json:"tag"`
+}
+
+type TagsRequest struct {
+	Tags Tags `json:"tags"`
+}
+
+// tagLabels returns the labels of tags, which may be nil.
+func tagLabels(tags *Tags) []string {
+	labels := []string{}
+	if tags == nil {
+		return labels
+	}
+	for _, tag := range tags.Tags {
+		labels = append(labels, tag.Label)
+	}
+
+	return labels
+}
+
+// UpdateContentTags adds and deletes tags of a content item of contentType,
+// e.g. ContentTypeWorkbooks, so that its tags change from current to desired.
+func (c *TableauClient) UpdateContentTags(ctx context.Context, contentType string, contentID string, current []string, desired []string) error {
+	return c.updateTags(ctx, fmt.Sprintf("/%s/%s", contentType, contentID), current, desired)
+}
+
+// updateTags adds and deletes the tags of the content at endpoint, such as
+// "/workbooks/{id}", so that its current tags become desired.
+func (c *TableauClient) updateTags(ctx context.Context, endpoint string, current []string, desired []string) error {
+	currentTags := make(map[string]bool, len(current))
+	for _, label := range current {
+		currentTags[label] = true
+	}
+	desiredTags := make(map[string]bool, len(desired))
+	for _, label := range desired {
+		desiredTags[label] = true
+	}
+
+	added := TagsRequest{Tags: Tags{Tags: []Tag{}}}
+	for _, label := range desired {
+		if !currentTags[label] {
+			added.Tags.Tags = append(added.Tags.Tags, Tag{Label: label})
+		}
+	}
+	if len(added.Tags.Tags) > 0 {
+		req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s%s/tags", c.ApiUrl, endpoint), added)
+		if err != nil {
+			return err
+		}
+		_, err = c.sendRequest(req)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, label := range current {
+		if desiredTags[label] {
+			continue
+		}
+		req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s%s/tags/%s", c.ApiUrl, endpoint, url.PathEscape(label)), nil)
+		if err != nil {
+			return err
+		}
+		_, err = c.sendRequest(req)
+		if err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestUpdateContentTags(t *testing.T) {
+	var requests []string
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	c := newTestClient(t, server)
+
+	err := c.UpdateContentTags(context.Background(), ContentTypeDatasources, "datasource-id", []string{"sales", "old tag"}, []string{"sales", "finance"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"PUT /api/3.18/sites/site-id/datasources/datasource-id/tags",
+		"DELETE /api/3.18/sites/site-id/datasources/datasource-id/tags/old%20tag",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("expected request %s, got %s", expected[i], requests[i])
+		}
+	}
+	if server.bodies[0] != `{"tags":{"tag":[{"label":"finance"}]}}` {
+		t.Errorf("expected only the finance tag to be added, got %s", server.bodies[0])
+	}
+}
@@ -1,19 +1,57 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"strings"
 )
 
 type User struct {
-	ID          string `json:"id,omitempty"`
-	Email       string `json:"email,omitempty"`
-	Name        string `json:"name,omitempty"`
-	SiteRole    string `json:"siteRole,omitempty"`
-	AuthSetting string `json:"authSetting,omitempty"`
+	ID                 string  `json:"id,omitempty"`
+	Email              string  `json:"email,omitempty"`
+	Name               string  `json:"name,omitempty"`
+	FullName           string  `json:"fullName,omitempty"`
+	SiteRole           string  `json:"siteRole,omitempty"`
+	AuthSetting        string  `json:"authSetting,omitempty"`
+	LastLogin          string  `json:"lastLogin,omitempty"`
+	Locale             string  `json:"locale,omitempty"`
+	Language           string  `json:"language,omitempty"`
+	ExternalAuthUserID string  `json:"externalAuthUserId,omitempty"`
+	IdpConfigurationID string  `json:"idpConfigurationId,omitempty"`
+	Domain             *Domain `json:"domain,omitempty"`
+}
+
+type Domain struct {
+	Name string `json:"name"`
+}
+
+// DomainName returns the name of the domain of the user, "local" for users
+// managed by Tableau itself on Tableau Server.
+func (u User) DomainName() string {
+	if u.Domain == nil {
+		return ""
+	}
+	return u.Domain.Name
+}
+
+// OwnedContentCounts is the number of workbooks and data sources owned by a
+// user.
+type OwnedContentCounts struct {
+	Workbooks   int
+	Datasources int
+}
+
+// OwnedContent is the workbooks and data sources owned by a user.
+type OwnedContent struct {
+	Workbooks   []Workbook
+	Datasources []Datasource
+}
+
+// IsEmpty reports whether the user owns no workbook nor data source.
+func (o OwnedContent) IsEmpty() bool {
+	return len(o.Workbooks) == 0 && len(o.Datasources) == 0
 }
 
 type UserRequest struct {
@@ -33,10 +71,21 @@ type GetUserResponse struct {
 	Pagination Pagination       `json:"pagination"`
 }
 
-func (c *TableauClient) CreateUser(email string, siteRole string, authSetting string) (*User, error) {
+func (r GetUserResponse) pageItems() []User {
+	return r.Users.Users
+}
+
+func (r GetUserResponse) pagination() Pagination {
+	return r.Pagination
+}
+
+// CreateUser adds a user to the site. When the user was added but setting its
+// full name failed, the added user is returned along with the error, so that
+// the caller can keep track of it.
+func (c *TableauClient) CreateUser(ctx context.Context, name string, email string, fullName string, siteRole string, authSetting string) (*User, error) {
 	newUser := User{
 		Email:       email,
-		Name:        email,
+		Name:        name,
 		SiteRole:    siteRole,
 		AuthSetting: authSetting,
 	}
@@ -44,12 +93,7 @@ func (c *TableauClient) CreateUser(email string, siteRole string, authSetting st
 		User: newUser,
 	}
 
-	payload, err := json.Marshal(userRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users", c.ApiUrl), strings.NewReader(string(payload)))
+	req, err := newRequest(ctx, "POST", fmt.Sprintf("%s/users", c.ApiUrl), userRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +109,21 @@ func (c *TableauClient) CreateUser(email string, siteRole string, authSetting st
 		return nil, err
 	}
 
+	// The full name can't be given when adding a user to the site, so it has
+	// to be set afterwards
+	if fullName != "" {
+		user, err := c.UpdateUser(ctx, resp.User.ID, email, fullName, siteRole, authSetting)
+		if err != nil {
+			return &resp.User, fmt.Errorf("setting full name of user %s: %w", resp.User.ID, err)
+		}
+		return user, nil
+	}
+
 	return &resp.User, nil
 }
 
-func (c *TableauClient) GetUser(userID string) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s/", c.ApiUrl, userID), nil)
+func (c *TableauClient) GetUser(ctx context.Context, userID string) (*User, error) {
+	req, err := newRequest(ctx, "GET", fmt.Sprintf("%s/users/%s/", c.ApiUrl, userID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,36 +142,169 @@ func (c *TableauClient) GetUser(userID string) (*User, error) {
 	return &resp.User, nil
 }
 
-func (c *TableauClient) GetUserByEmail(userEmail string) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users?filter=name:eq:%s", c.ApiUrl, url.QueryEscape(userEmail)), nil)
+// ListUsers lists the users matching a Tableau filter expression such as
+// "siteRole:eq:Creator", in the order given by a sort expression such as
+// "name:asc". Every user is listed when filter is empty. Users are listed
+// with all their details.
+func (c *TableauClient) ListUsers(ctx context.Context, filter string, sort string) ([]User, error) {
+	query := url.Values{}
+	query.Set("fields", "_all_")
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	if sort != "" {
+		query.Set("sort", sort)
+	}
+
+	return listAll[User, GetUserResponse](ctx, c, fmt.Sprintf("%s/users?%s", c.ApiUrl, query.Encode()))
+}
+
+// GetUserByName looks up a user by username, regardless of case.
+func (c *TableauClient) GetUserByName(ctx context.Context, name string) (*User, error) {
+	users, err := listAll[User, GetUserResponse](ctx, c, fmt.Sprintf("%s/users?filter=name:eq:%s", c.ApiUrl, url.QueryEscape(name)))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.sendRequest(req)
+	for _, user := range users {
+		if strings.EqualFold(user.Name, name) {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find user with name '%s': %w", name, ErrNotFound)
+}
+
+// GetUserByEmail looks up a user by email, regardless of case.
+func (c *TableauClient) GetUserByEmail(ctx context.Context, userEmail string) (*User, error) {
+	// Usernames are usually the email, which the name filter finds with a
+	// single request
+	users, err := listAll[User, GetUserResponse](ctx, c, fmt.Sprintf("%s/users?filter=name:eq:%s", c.ApiUrl, url.QueryEscape(userEmail)))
 	if err != nil {
 		return nil, err
 	}
 
-	resp := GetUserResponse{}
-	err = json.Unmarshal(body, &resp)
+	for _, user := range users {
+		// Tableau matches emails regardless of case
+		if strings.EqualFold(user.Email, userEmail) {
+			return &user, nil
+		}
+	}
+
+	// Otherwise the username differs from the email, which can't be filtered
+	// on, so every user has to be searched
+	index, err := c.NewUserIndex(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, user := range resp.Users.Users {
-		if user.Email == userEmail {
-			return &user, nil
+	return index.GetUserByEmail(userEmail)
+}
+
+// GetUserOwnedContentCounts counts the workbooks and data sources owned by
+// user, without listing them.
+func (c *TableauClient) GetUserOwnedContentCounts(ctx context.Context, user *User) (*OwnedContentCounts, error) {
+	workbooks, err := c.countAll(ctx, fmt.Sprintf("%s/users/%s/workbooks?ownedBy=true", c.ApiUrl, user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	datasources, err := c.countAll(ctx, c.ownedDatasourcesUrl(user))
+	if err != nil {
+		return nil, err
+	}
+
+	return &OwnedContentCounts{
+		Workbooks:   workbooks,
+		Datasources: datasources,
+	}, nil
+}
+
+// ownedDatasourcesUrl is the URL listing the data sources owned by user. Data
+// sources can only be filtered by owner name, which different domains may
+// share, so they are filtered by the domain of the user as well when known.
+func (c *TableauClient) ownedDatasourcesUrl(user *User) string {
+	filter := "ownerName:eq:" + url.QueryEscape(user.Name)
+	if domain := user.DomainName(); domain != "" {
+		filter += ",ownerDomain:eq:" + url.QueryEscape(domain)
+	}
+	return fmt.Sprintf("%s/datasources?filter=%s", c.ApiUrl, filter)
+}
+
+// ListUserOwnedContent lists the workbooks and data sources owned by user.
+func (c *TableauClient) ListUserOwnedContent(ctx context.Context, user *User) (*OwnedContent, error) {
+	workbooks, err := listAll[Workbook, GetWorkbookResponse](ctx, c, fmt.Sprintf("%s/users/%s/workbooks?ownedBy=true", c.ApiUrl, user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	datasources, err := listAll[Datasource, GetDatasourceResponse](ctx, c, c.ownedDatasourcesUrl(user))
+	if err != nil {
+		return nil, err
+	}
+
+	// Users of an unknown domain may share their name with other users
+	var ownedDatasources []Datasource
+	for _, datasource := range datasources {
+		if datasource.Owner == nil || datasource.Owner.ID == user.ID {
+			ownedDatasources = append(ownedDatasources, datasource)
 		}
 	}
 
-	return nil, fmt.Errorf("unable to find user with email '%s'", userEmail)
+	return &OwnedContent{
+		Workbooks:   workbooks,
+		Datasources: ownedDatasources,
+	}, nil
+}
+
+// UserIndex resolves user emails from a single listing of the site's users,
+// so that operations on many users don't have to look up each one of them.
+// Emails are matched regardless of case.
+type UserIndex struct {
+	usersByEmail map[string]User
+}
+
+// NewUserIndex lists every user of the site and indexes them by email.
+func (c *TableauClient) NewUserIndex(ctx context.Context) (*UserIndex, error) {
+	users, err := c.ListUsers(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	index := UserIndex{
+		usersByEmail: make(map[string]User, len(users)),
+	}
+	for _, user := range users {
+		index.usersByEmail[strings.ToLower(user.Email)] = user
+	}
+
+	return &index, nil
+}
+
+func (i *UserIndex) GetUserByEmail(userEmail string) (*User, error) {
+	user, ok := i.usersByEmail[strings.ToLower(userEmail)]
+	if !ok {
+		return nil, fmt.Errorf("unable to find user with email '%s': %w", userEmail, ErrNotFound)
+	}
+
+	return &user, nil
+}
+
+// resolveUserEmail looks up a user by email in users, or on the server when
+// no index is given.
+func (c *TableauClient) resolveUserEmail(ctx context.Context, users *UserIndex, userEmail string) (*User, error) {
+	if users == nil {
+		return c.GetUserByEmail(ctx, userEmail)
+	}
+
+	return users.GetUserByEmail(userEmail)
 }
 
-func (c *TableauClient) UpdateUser(userID string, email string, siteRole string, authSetting string) (*User, error) {
+// UpdateUser updates the details of a user. Usernames can't be changed.
+func (c *TableauClient) UpdateUser(ctx context.Context, userID string, email string, fullName string, siteRole string, authSetting string) (*User, error) {
 	updatedUser := User{
 		Email:       email,
-		Name:        email,
+		FullName:    fullName,
 		SiteRole:    siteRole,
 		AuthSetting: authSetting,
 	}
@@ -125,12 +312,7 @@ func (c *TableauClient) UpdateUser(userID string, email string, siteRole string,
 		User: updatedUser,
 	}
 
-	payload, err := json.Marshal(userRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), strings.NewReader(string(payload)))
+	req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), userRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -149,8 +331,15 @@ func (c *TableauClient) UpdateUser(userID string, email string, siteRole string,
 	return &resp.User, nil
 }
 
-func (c *TableauClient) DeleteUser(userID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/users/%s", c.ApiUrl, userID), nil)
+// DeleteUser removes the user from the site. When mapAssetsTo is set, the
+// content owned by the user is transferred to the user with that ID first.
+func (c *TableauClient) DeleteUser(ctx context.Context, userID string, mapAssetsTo string) error {
+	endpoint := fmt.Sprintf("%s/users/%s", c.ApiUrl, userID)
+	if mapAssetsTo != "" {
+		endpoint = fmt.Sprintf("%s?mapAssetsTo=%s", endpoint, url.QueryEscape(mapAssetsTo))
+	}
+
+	req, err := newRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return err
 	}
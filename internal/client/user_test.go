@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGroupMembershipByUserEmailWithIndex(t *testing.T) {
+	var lists, adds []string
+	var server *testServer
+	server = newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/users"):
+			lists = append(lists, r.URL.RawQuery)
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"2"},"users":{"user":[{"id":"id-1","email":"one@example.com"},{"id":"id-2","email":"two@example.com"}]}}`)
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/groups/group-id/users"):
+			adds = append(adds, server.bodies[attempt-1])
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"user":{}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	users, err := c.NewUserIndex(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, email := range []string{"one@example.com", "Two@Example.com"} {
+		err = c.CreateGroupMembershipByUserEmail(ctx, users, "group-id", email)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	err = c.CreateGroupMembershipByUserEmail(ctx, users, "group-id", "three@example.com")
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if len(lists) != 1 {
+		t.Errorf("expected users to be listed once, got %d times", len(lists))
+	}
+	expected := []string{`{"user":{"id":"id-1"}}`, `{"user":{"id":"id-2"}}`}
+	if strings.Join(adds, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected %v to be added, got %v", expected, adds)
+	}
+}
+
+func TestGetUserOwnedContentCounts(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageSize") != "1" {
+			t.Errorf("expected a single item to be fetched, got page size %s", r.URL.Query().Get("pageSize"))
+		}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/user-id/workbooks") && r.URL.Query().Get("ownedBy") == "true":
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"12"},"workbooks":{"workbook":[{"id":"workbook-id"}]}}`)
+		case strings.HasSuffix(r.URL.Path, "/datasources") && r.URL.Query().Get("filter") == "ownerName:eq:user@example.com,ownerDomain:eq:local":
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"3"},"datasources":{"datasource":[{"id":"datasource-id"}]}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c := newTestClient(t, server)
+
+	counts, err := c.GetUserOwnedContentCounts(context.Background(), &User{ID: "user-id", Name: "user@example.com", Domain: &Domain{Name: "local"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if counts.Workbooks != 12 || counts.Datasources != 3 {
+		t.Errorf("expected 12 workbooks and 3 data sources, got %+v", counts)
+	}
+}
+
+func TestListUserOwnedContent(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/user-id/workbooks") && r.URL.Query().Get("ownedBy") == "true":
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"2"},"workbooks":{"workbook":[{"id":"sales-id","name":"Sales"},{"id":"costs-id","name":"Costs"}]}}`)
+		case strings.HasSuffix(r.URL.Path, "/datasources") && r.URL.Query().Get("filter") == "ownerName:eq:user@example.com":
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"0"},"datasources":{}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c := newTestClient(t, server)
+
+	ownedContent, err := c.ListUserOwnedContent(context.Background(), &User{ID: "user-id", Name: "user@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ownedContent.IsEmpty() || len(ownedContent.Workbooks) != 2 || len(ownedContent.Datasources) != 0 {
+		t.Errorf("expected 2 workbooks and no data source, got %+v", ownedContent)
+	}
+	if ownedContent.Workbooks[1].Name != "Costs" {
+		t.Errorf("expected the second workbook to be Costs, got %s", ownedContent.Workbooks[1].Name)
+	}
+}
+
+func TestListUserOwnedContentKeepsDatasourcesOfUser(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/user-id/workbooks"):
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"0"},"workbooks":{}}`)
+		case strings.HasSuffix(r.URL.Path, "/datasources") && r.URL.Query().Get("filter") == "ownerName:eq:jdoe,ownerDomain:eq:example.com":
+			fmt.Fprint(w, `{"pagination":{"totalAvailable":"2"},"datasources":{"datasource":[
+				{"id":"sales-id","name":"Sales","owner":{"id":"user-id"}},
+				{"id":"costs-id","name":"Costs","owner":{"id":"other-user-id"}}
+			]}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	c := newTestClient(t, server)
+
+	ownedContent, err := c.ListUserOwnedContent(context.Background(), &User{ID: "user-id", Name: "jdoe", Domain: &Domain{Name: "example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ownedContent.Datasources) != 1 || ownedContent.Datasources[0].Name != "Sales" {
+		t.Errorf("expected only the Sales data source, got %+v", ownedContent.Datasources)
+	}
+}
+
+func TestDeleteUserMapsAssets(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || !strings.HasSuffix(r.URL.Path, "/users/user-id") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("mapAssetsTo") != "new-owner-id" {
+			t.Errorf("expected the content to be mapped to new-owner-id, got query %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c := newTestClient(t, server)
+
+	err := c.DeleteUser(context.Background(), "user-id", "new-owner-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestCreateUserReturnsUserWhenSettingFullNameFails(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"user":{"id":"user-id","name":"user@example.com"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":{"code":"400000","summary":"Bad Request","detail":"Invalid full name"}}`)
+	})
+	c := newTestClient(t, server)
+
+	user, err := c.CreateUser(context.Background(), "user@example.com", "user@example.com", "User", "Viewer", "SAML")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if user == nil || user.ID != "user-id" {
+		t.Fatalf("expected the added user along with the error, got %+v", user)
+	}
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type Workbook struct {
+	ID          string          `json:"id,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	ContentUrl  string          `json:"contentUrl,omitempty"`
+	WebpageUrl  string          `json:"webpageUrl,omitempty"`
+	ShowTabs    FlexBool        `json:"showTabs"`
+	Project     *ContentProject `json:"project,omitempty"`
+	Owner       *Owner          `json:"owner,omitempty"`
+	Tags        *Tags           `json:"tags,omitempty"`
+}
+
+// ProjectID returns the ID of the project of the workbook, empty when not
+// returned.
+func (w Workbook) ProjectID() string {
+	if w.Project == nil {
+		return ""
+	}
+	return w.Project.ID
+}
+
+// TagLabels returns the labels of the tags of the workbook.
+func (w Workbook) TagLabels() []string {
+	return tagLabels(w.Tags)
+}
+
+// WorkbookUpdate is sent when updating a workbook. Unlike Workbook, the
+// description is always sent so that it can be cleared.
+type WorkbookUpdate struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	ShowTabs    FlexBool        `json:"showTabs"`
+	Project     *ContentProject `json:"project,omitempty"`
+}
+
+type WorkbookRequest struct {
+	Workbook Workbook `json:"workbook"`
+}
+
+type WorkbookUpdateRequest struct {
+	Workbook WorkbookUpdate `json:"workbook"`
+}
+
+type WorkbookResponse struct {
+	Workbook Workbook `json:"workbook"`
+}
+
+type WorkbookListResponse struct {
+	Workbooks []Workbook `json:"workbook"`
+}
+
+type GetWorkbookResponse struct {
+	Workbooks  WorkbookListResponse `json:"workbooks"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+func (r GetWorkbookResponse) pageItems() []Workbook {
+	return r.Workbooks.Workbooks
+}
+
+func (r GetWorkbookResponse) pagination() Pagination {
+	return r.Pagination
+}
+
+// PublishWorkbook publishes the .twb or .twbx file at filePath as a workbook
+// described by workbook. With overwrite, a workbook with the same name in the
+// same project is replaced instead of failing the publish.
+func (c *TableauClient) PublishWorkbook(ctx context.Context, filePath string, workbook Workbook, overwrite bool) (*Workbook, error) {
+	workbookType := strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), ".")
+	if workbookType != "twb" && workbookType != "twbx" {
+		return nil, fmt.Errorf("unsupported workbook file %s, expected a .twb or .twbx file", filePath)
+	}
+
+	query := url.Values{}
+	query.Set("workbookType", workbookType)
+	query.Set("overwrite", strconv.FormatBool(overwrite))
+
+	body, err := c.publishFile(ctx, "/workbooks", query, WorkbookRequest{Workbook: workbook}, "tableau_workbook", filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := WorkbookResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Workbook, nil
+}
+
+func (c *TableauClient) GetWorkbook(ctx context.Context, workbookID string) (*Workbook, error) {
+	req, err := newRequest(ctx, "GET", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := WorkbookResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Workbook, nil
+}
+
+func (c *TableauClient) UpdateWorkbook(ctx context.Context, workbookID string, name string, description string, showTabs bool, projectID string) (*Workbook, error) {
+	workbookRequest := WorkbookUpdateRequest{
+		Workbook: WorkbookUpdate{
+			Name:        name,
+			Description: description,
+			ShowTabs:    FlexBool(showTabs),
+			Project:     &ContentProject{ID: projectID},
+		},
+	}
+
+	req, err := newRequest(ctx, "PUT", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), workbookRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := WorkbookResponse{}
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Workbook, nil
+}
+
+func (c *TableauClient) DeleteWorkbook(ctx context.Context, workbookID string) error {
+	req, err := newRequest(ctx, "DELETE", fmt.Sprintf("%s/workbooks/%s", c.ApiUrl, workbookID), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
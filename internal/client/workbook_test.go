@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readMultipart returns the content of every part of a multipart/mixed body,
+// keyed by part name.
+func readMultipart(t *testing.T, contentType string, body string) map[string]string {
+	t.Helper()
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("expected a multipart/mixed body, got content type '%s'", contentType)
+	}
+
+	parts := map[string]string{}
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("reading multipart body: %s", err)
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("reading part %s: %s", part.FormName(), err)
+		}
+		parts[part.FormName()] = string(content)
+	}
+
+	return parts
+}
+
+func writeWorkbookFile(t *testing.T, content string) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), "Sales.twbx")
+	err := os.WriteFile(filePath, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("writing workbook file: %s", err)
+	}
+
+	return filePath
+}
+
+func TestPublishWorkbook(t *testing.T) {
+	var server *testServer
+	server = newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/sites/site-id/workbooks") {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("workbookType") != "twbx" || r.URL.Query().Get("overwrite") != "true" {
+			t.Errorf("unexpected publish query %s", r.URL.RawQuery)
+		}
+
+		parts := readMultipart(t, r.Header.Get("Content-Type"), server.bodies[attempt-1])
+		if !strings.Contains(parts["request_payload"], `"name":"Sales"`) || !strings.Contains(parts["request_payload"], `"showTabs":"true"`) {
+			t.Errorf("unexpected request payload %s", parts["request_payload"])
+		}
+		if parts["tableau_workbook"] != "workbook content" {
+			t.Errorf("unexpected workbook content '%s'", parts["tableau_workbook"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"workbook":{"id":"workbook-id","name":"Sales","showTabs":"true","project":{"id":"project-id"}}}`)
+	})
+	c := newTestClient(t, server)
+
+	workbook, err := c.PublishWorkbook(
+		context.Background(),
+		writeWorkbookFile(t, "workbook content"),
+		Workbook{Name: "Sales", ShowTabs: true, Project: &ContentProject{ID: "project-id"}},
+		true,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workbook.ID != "workbook-id" || workbook.ProjectID() != "project-id" || !workbook.ShowTabs {
+		t.Errorf("unexpected workbook %+v", workbook)
+	}
+}
+
+func TestPublishWorkbookUsesUploadTimeout(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		// Slower than the timeout of other requests
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"workbook":{"id":"workbook-id","name":"Sales"}}`)
+	})
+	c := newTestClient(t, server)
+	c.HTTPClient.Timeout = 50 * time.Millisecond
+
+	_, err := c.PublishWorkbook(context.Background(), writeWorkbookFile(t, "workbook content"), Workbook{Name: "Sales"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(server.bodies) != 1 {
+		t.Errorf("expected the publish to be sent once, got %d requests", len(server.bodies))
+	}
+}
+
+func TestPublishWorkbookInChunks(t *testing.T) {
+	defaultChunkSize := uploadChunkSize
+	uploadChunkSize = 4
+	t.Cleanup(func() { uploadChunkSize = defaultChunkSize })
+
+	var chunks []string
+	var server *testServer
+	server = newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/fileUploads"):
+			fmt.Fprint(w, `{"fileUpload":{"uploadSessionId":"session-id","fileSize":"0"}}`)
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/fileUploads/session-id"):
+			parts := readMultipart(t, r.Header.Get("Content-Type"), server.bodies[attempt-1])
+			chunks = append(chunks, parts["tableau_file"])
+			fmt.Fprint(w, `{"fileUpload":{"uploadSessionId":"session-id"}}`)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/workbooks"):
+			if r.URL.Query().Get("uploadSessionId") != "session-id" {
+				t.Errorf("expected the upload session to be published, got query %s", r.URL.RawQuery)
+			}
+			parts := readMultipart(t, r.Header.Get("Content-Type"), server.bodies[attempt-1])
+			if _, ok := parts["tableau_workbook"]; ok {
+				t.Errorf("expected the workbook content to only be sent through the upload session")
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"workbook":{"id":"workbook-id","name":"Sales"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	c := newTestClient(t, server)
+
+	_, err := c.PublishWorkbook(context.Background(), writeWorkbookFile(t, "0123456789"), Workbook{Name: "Sales"}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"0123", "4567", "89"}
+	if strings.Join(chunks, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected chunks %v, got %v", expected, chunks)
+	}
+}
+
+func TestPublishWorkbookRejectsOtherFiles(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	c := newTestClient(t, server)
+
+	_, err := c.PublishWorkbook(context.Background(), "Sales.tdsx", Workbook{Name: "Sales"}, false)
+	if err == nil {
+		t.Fatalf("expected an error for a file that is not a workbook")
+	}
+}
+
+func TestAppendToFileUploadIsNotRetried(t *testing.T) {
+	server := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c := newTestClient(t, server)
+
+	err := c.appendToFileUpload(context.Background(), "session-id", []byte("chunk"))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(server.bodies) != 1 {
+		t.Errorf("expected a single attempt, got %d", len(server.bodies))
+	}
+}
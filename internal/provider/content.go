@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Helpers shared by the resources publishing content files, such as
+// workbooks and data sources.
+
+// tagsValue returns the set of the tag labels.
+func tagsValue(labels []string) types.Set {
+	tags := []attr.Value{}
+	for _, label := range labels {
+		tags = append(tags, types.StringValue(label))
+	}
+
+	return types.SetValueMust(types.StringType, tags)
+}
+
+// publishedContent is a content item published from a file, such as a
+// workbook or a data source.
+type publishedContent interface {
+	TagLabels() []string
+}
+
+// contentPublisher publishes and tags the content items of a content type,
+// such as workbooks, the same way for every resource publishing files.
+type contentPublisher[C publishedContent] struct {
+	client *client.TableauClient
+	// contentType is the client content type, e.g. client.ContentTypeWorkbooks.
+	contentType string
+	// get fetches a content item by ID.
+	get func(ctx context.Context, contentID string) (C, error)
+	// id returns the ID of a content item.
+	id func(content C) string
+}
+
+// create publishes a new content item with publish, then tags it, as tags
+// can't be published along with the file. It returns the refreshed item. When
+// tagging fails, the published item is returned along with the error, so that
+// it gets recorded rather than published again next to itself.
+func (p contentPublisher[C]) create(ctx context.Context, publish func(ctx context.Context) (C, error), tags []string) (C, error) {
+	content, err := publish(ctx)
+	if err != nil {
+		return content, err
+	}
+
+	refreshedContent, err := p.tag(ctx, p.id(content), tags)
+	if err != nil {
+		return content, err
+	}
+
+	return refreshedContent, nil
+}
+
+// update publishes the file of the content item again with publish, unless it
+// is nil, then updates its tags. It returns the refreshed item.
+func (p contentPublisher[C]) update(ctx context.Context, contentID string, publish func(ctx context.Context) (C, error), tags []string) (C, error) {
+	if publish != nil {
+		content, err := publish(ctx)
+		if err != nil {
+			return content, err
+		}
+	}
+
+	return p.tag(ctx, contentID, tags)
+}
+
+// tag updates the tags of the content item to tags, and returns the refreshed
+// item.
+func (p contentPublisher[C]) tag(ctx context.Context, contentID string, tags []string) (C, error) {
+	content, err := p.get(ctx, contentID)
+	if err != nil {
+		return content, err
+	}
+
+	err = p.client.UpdateContentTags(ctx, p.contentType, contentID, content.TagLabels(), tags)
+	if err != nil {
+		var noContent C
+		return noContent, err
+	}
+
+	return p.get(ctx, contentID)
+}
+
+// planFileContentHash sets the planned content_hash to the hash of the file at
+// the planned file_path. The hash stays unknown while the path is.
+func planFileContentHash(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to publish when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filePath types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("file_path"), &filePath)...)
+	if resp.Diagnostics.HasError() || filePath.IsUnknown() {
+		return
+	}
+
+	contentHash, err := fileContentHash(filePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Unable to Read File",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), contentHash)...)
+}
+
+// fileContentHash returns the hex encoded SHA-256 hash of the file content.
+func fileContentHash(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &contentConnectionResource{}
+	_ resource.ResourceWithConfigure   = &contentConnectionResource{}
+	_ resource.ResourceWithImportState = &contentConnectionResource{}
+)
+
+// contentConnectionResource manages an existing connection of a published
+// workbook or data source. The content ID attribute is named after the
+// content type, so the model is read and written by attribute path.
+type contentConnectionResource struct {
+	client *client.TableauClient
+
+	// contentType is the client content type, e.g. client.ContentTypeWorkbooks.
+	contentType string
+	// typeName is appended to the provider type name, e.g. "workbook".
+	typeName string
+	// noun names the content in descriptions, e.g. "data source".
+	noun string
+	// title names the content in diagnostic summaries, e.g. "Data Source".
+	title string
+}
+
+type contentConnectionResourceModel struct {
+	ID            types.String
+	ContentID     types.String
+	ConnectionID  types.String
+	ServerAddress types.String
+	ServerPort    types.String
+	Username      types.String
+	Password      types.String
+	EmbedPassword types.Bool
+	Type          types.String
+}
+
+func NewDatasourceConnectionResource() resource.Resource {
+	return &contentConnectionResource{
+		contentType: client.ContentTypeDatasources,
+		typeName:    "datasource",
+		noun:        "data source",
+		title:       "Data Source",
+	}
+}
+
+func NewWorkbookConnectionResource() resource.Resource {
+	return &contentConnectionResource{
+		contentType: client.ContentTypeWorkbooks,
+		typeName:    "workbook",
+		noun:        "workbook",
+		title:       "Workbook",
+	}
+}
+
+// attributes maps the attribute names of the resource to the fields of model.
+func (r *contentConnectionResource) attributes(model *contentConnectionResourceModel) map[string]any {
+	return map[string]any{
+		"id":               &model.ID,
+		r.typeName + "_id": &model.ContentID,
+		"connection_id":    &model.ConnectionID,
+		"server_address":   &model.ServerAddress,
+		"server_port":      &model.ServerPort,
+		"username":         &model.Username,
+		"password":         &model.Password,
+		"embed_password":   &model.EmbedPassword,
+		"type":             &model.Type,
+	}
+}
+
+// get reads model from a plan or state.
+func (r *contentConnectionResource) get(ctx context.Context, source interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, model *contentConnectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, field := range r.attributes(model) {
+		diags.Append(source.GetAttribute(ctx, path.Root(name), field)...)
+	}
+	return diags
+}
+
+// setState writes model to state.
+func (r *contentConnectionResource) setState(ctx context.Context, state *tfsdk.State, model contentConnectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, field := range r.attributes(&model) {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), field)...)
+	}
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *contentConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName + "_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the server and credentials of an existing connection of a published " + r.noun + ". " +
+			"Destroying the resource leaves the connection as is",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: strings.ToUpper(r.noun[:1]) + r.noun[1:] + " connection ID, in the form `" + r.typeName + "_id/connection_id`",
+			},
+			r.typeName + "_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the " + r.noun,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the connection of the " + r.noun,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// The attributes left unset keep their current value on Tableau
+			"server_address": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Address of the database server",
+			},
+			"server_port": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Port of the database server",
+			},
+			"username": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Username of the connection",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Password of the connection. Tableau never returns passwords, " +
+					"so changes made outside of Terraform are not detected",
+			},
+			"embed_password": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether the password is embedded in the connection, rather than prompted to its users",
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Type of the connection, e.g. `postgres` or `sqlserver`",
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *contentConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan contentConnectionResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connections come with their content, so only update it
+	connection, err := r.updateConnection(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Tableau "+r.title+" Connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Set ID and computed values
+	setContentConnectionResourceModel(&plan, connection)
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+}
+
+// Read resource information.
+func (r *contentConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state contentConnectionResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	connection, err := r.client.GetContentConnection(ctx, r.contentType, state.ContentID.ValueString(), state.ConnectionID.ValueString())
+	if client.IsNotFound(err) {
+		// Content or connection was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau "+r.title+" Connection",
+			"Could not read connection "+state.ConnectionID.ValueString()+" of Tableau "+r.noun+" "+state.ContentID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state, the password can't be read back
+	setContentConnectionResourceModel(&state, connection)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *contentConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan contentConnectionResourceModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update connection
+	connection, err := r.updateConnection(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Tableau "+r.title+" Connection",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	setContentConnectionResourceModel(&plan, connection)
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+}
+
+// Delete removes the resource from the Terraform state. Connections can't be
+// deleted apart from their content, so the connection is left as is.
+func (r *contentConnectionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *contentConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *contentConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Split import ID into content and connection IDs
+	ids := strings.Split(req.ID, "/")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s_id/connection_id. Got: %q", r.typeName, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.typeName+"_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), ids[1])...)
+}
+
+// updateConnection sends the configured values of the connection, and returns
+// the refreshed connection.
+func (r *contentConnectionResource) updateConnection(ctx context.Context, plan contentConnectionResourceModel) (*client.Connection, error) {
+	connection := client.Connection{
+		ServerAddress: plan.ServerAddress.ValueString(),
+		ServerPort:    plan.ServerPort.ValueString(),
+		UserName:      plan.Username.ValueString(),
+		Password:      plan.Password.ValueString(),
+	}
+	if !plan.EmbedPassword.IsNull() && !plan.EmbedPassword.IsUnknown() {
+		embedPassword := client.FlexBool(plan.EmbedPassword.ValueBool())
+		connection.EmbedPassword = &embedPassword
+	}
+
+	_, err := r.client.UpdateContentConnection(ctx, r.contentType, plan.ContentID.ValueString(), plan.ConnectionID.ValueString(), connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.GetContentConnection(ctx, r.contentType, plan.ContentID.ValueString(), plan.ConnectionID.ValueString())
+}
+
+func setContentConnectionResourceModel(model *contentConnectionResourceModel, connection *client.Connection) {
+	model.ID = types.StringValue(model.ContentID.ValueString() + "/" + connection.ID)
+	model.ConnectionID = types.StringValue(connection.ID)
+	model.ServerAddress = types.StringValue(connection.ServerAddress)
+	model.ServerPort = types.StringValue(connection.ServerPort)
+	model.Username = types.StringValue(connection.UserName)
+	model.EmbedPassword = types.BoolValue(connection.EmbedPassword != nil && bool(*connection.EmbedPassword))
+	model.Type = types.StringValue(connection.Type)
+}
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &contentPermissionsResource{}
+	_ resource.ResourceWithConfigure   = &contentPermissionsResource{}
+	_ resource.ResourceWithImportState = &contentPermissionsResource{}
+)
+
+// contentPermissionsResource manages the permissions of a single workbook,
+// data source or view. The content ID attribute is named after the content
+// type, so the model is read and written by attribute path.
+type contentPermissionsResource struct {
+	client *client.TableauClient
+
+	// contentType is the client content type, e.g. client.ContentTypeWorkbooks.
+	contentType string
+	// typeName is appended to the provider type name, e.g. "workbook".
+	typeName string
+	// noun names the content in descriptions, e.g. "data source".
+	noun string
+	// title names the content in diagnostic summaries, e.g. "Data Source".
+	title string
+	// description is added to the description of the resource.
+	description string
+}
+
+type contentPermissionsResourceModel struct {
+	ContentID   types.String
+	Permissions types.Set
+}
+
+func NewWorkbookPermissionsResource() resource.Resource {
+	return &contentPermissionsResource{
+		contentType: client.ContentTypeWorkbooks,
+		typeName:    "workbook",
+		noun:        "workbook",
+		title:       "Workbook",
+	}
+}
+
+func NewDatasourcePermissionsResource() resource.Resource {
+	return &contentPermissionsResource{
+		contentType: client.ContentTypeDatasources,
+		typeName:    "datasource",
+		noun:        "data source",
+		title:       "Data Source",
+	}
+}
+
+func NewViewPermissionsResource() resource.Resource {
+	return &contentPermissionsResource{
+		contentType: client.ContentTypeViews,
+		typeName:    "view",
+		noun:        "view",
+		title:       "View",
+		description: " Permissions of views only apply when the workbook of the view doesn't show its sheets as tabs.",
+	}
+}
+
+// idAttribute is the attribute holding the ID of the content item.
+func (r *contentPermissionsResource) idAttribute() path.Path {
+	return path.Root(r.typeName + "_id")
+}
+
+// Metadata returns the resource type name.
+func (r *contentPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName + "_permissions"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the permissions of a " + r.noun + ". Capabilities granted outside of Terraform are removed." +
+			r.description,
+		Attributes: map[string]schema.Attribute{
+			r.typeName + "_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the " + r.noun,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": granteeCapabilitiesAttribute("Capabilities of users and groups on the "+r.noun, true),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *contentPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan contentPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, r.idAttribute(), &plan.ContentID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &plan.Permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the permissions of the content
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+}
+
+// Read resource information.
+func (r *contentPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state contentPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, r.idAttribute(), &state.ContentID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	permissions, err := r.client.GetContentPermissions(ctx, r.contentType, state.ContentID.ValueString())
+	if client.IsNotFound(err) {
+		// Content was deleted outside of Terraform, so the permissions must be recreated
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau "+r.title+" Permissions",
+			"Could not read permissions of Tableau "+r.noun+" "+state.ContentID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	var diags diag.Diagnostics
+	state.Permissions, diags = flattenPermissions(ctx, permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *contentPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan contentPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, r.idAttribute(), &plan.ContentID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &plan.Permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add and delete capabilities to match the plan
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(r.setState(ctx, &resp.State, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *contentPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state contentPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, r.idAttribute(), &state.ContentID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("permissions"), &state.Permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete managed capabilities
+	permissions, diags := expandPermissions(ctx, state.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, permission := range permissions {
+		err := r.client.DeleteContentPermission(ctx, r.contentType, state.ContentID.ValueString(), permission)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau "+r.title+" Permission",
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *contentPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *contentPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to the content ID attribute
+	resource.ImportStatePassthroughID(ctx, r.idAttribute(), req, resp)
+}
+
+// apply adds and deletes capabilities so that the content matches the model,
+// then refreshes the model from the server.
+func (r *contentPermissionsResource) apply(ctx context.Context, model *contentPermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	contentID := model.ContentID.ValueString()
+
+	desired, expandDiags := expandPermissions(ctx, model.Permissions)
+	diags.Append(expandDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	current, err := r.client.GetContentPermissions(ctx, r.contentType, contentID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau "+r.title+" Permissions",
+			"Could not read permissions of Tableau "+r.noun+" "+contentID+": "+err.Error(),
+		)
+		return diags
+	}
+	err = applyPermissions(
+		current,
+		desired,
+		func(permissions []client.Permission) error {
+			return r.client.AddContentPermissions(ctx, r.contentType, contentID, permissions)
+		},
+		func(permission client.Permission) error {
+			return r.client.DeleteContentPermission(ctx, r.contentType, contentID, permission)
+		},
+	)
+	if err != nil {
+		diags.AddError(
+			"Unable to Update Tableau "+r.title+" Permissions",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// Get updated values
+	permissions, err := r.client.GetContentPermissions(ctx, r.contentType, contentID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau "+r.title+" Permissions",
+			"Could not read permissions of Tableau "+r.noun+" "+contentID+": "+err.Error(),
+		)
+		return diags
+	}
+
+	var flattenDiags diag.Diagnostics
+	model.Permissions, flattenDiags = flattenPermissions(ctx, permissions)
+	diags.Append(flattenDiags...)
+	return diags
+}
+
+// setState writes the model to the content ID and permissions attributes of
+// the state.
+func (r *contentPermissionsResource) setState(ctx context.Context, state *tfsdk.State, model contentPermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, r.idAttribute(), model.ContentID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("permissions"), model.Permissions)...)
+	return diags
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourceConnectionResource(t *testing.T) {
+	// Connection IDs are generated when publishing, so the test runs against
+	// an existing data source
+	datasourceID, connectionID := os.Getenv("TABLEAU_TEST_DATASOURCE_ID"), os.Getenv("TABLEAU_TEST_DATASOURCE_CONNECTION_ID")
+	if datasourceID == "" || connectionID == "" {
+		t.Skip("TABLEAU_TEST_DATASOURCE_ID and TABLEAU_TEST_DATASOURCE_CONNECTION_ID must be set")
+	}
+
+	// Test cases for data source connection resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tableau_datasource_connection" "uat_terraform_provider_test" {
+	datasource_id  = %q
+	connection_id  = %q
+	username       = "uat_reader"
+	password       = "uat-password"
+	embed_password = true
+}
+`, datasourceID, connectionID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource_connection.uat_terraform_provider_test", "id", datasourceID+"/"+connectionID),
+					resource.TestCheckResourceAttr("tableau_datasource_connection.uat_terraform_provider_test", "username", "uat_reader"),
+					resource.TestCheckResourceAttr("tableau_datasource_connection.uat_terraform_provider_test", "embed_password", "true"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_datasource_connection.uat_terraform_provider_test", "server_address"),
+					resource.TestCheckResourceAttrSet("tableau_datasource_connection.uat_terraform_provider_test", "type"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_datasource_connection.uat_terraform_provider_test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tableau_datasource_connection" "uat_terraform_provider_test" {
+	datasource_id  = %q
+	connection_id  = %q
+	username       = "uat_reader_updated"
+	embed_password = false
+}
+`, datasourceID, connectionID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource_connection.uat_terraform_provider_test", "username", "uat_reader_updated"),
+					resource.TestCheckResourceAttr("tableau_datasource_connection.uat_terraform_provider_test", "embed_password", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
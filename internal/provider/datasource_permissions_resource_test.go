@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatasourcePermissionsResource(t *testing.T) {
+	filePath := writeTestAccDatasource(t, "orders")
+
+	config := func(write string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-datasource-permissions"
+}
+
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-datasource-permissions"
+}
+
+resource "tableau_datasource" "uat_terraform_provider_test" {
+	name       = "uat-terraform-provider-test"
+	project_id = tableau_project.uat_terraform_provider_test.id
+	file_path  = %q
+}
+
+resource "tableau_datasource_permissions" "uat_terraform_provider_test" {
+	datasource_id = tableau_datasource.uat_terraform_provider_test.id
+	permissions = [
+		{
+			group_id     = tableau_group.uat_terraform_provider_test.id
+			capabilities = {
+				Read  = "Allow"
+				Write = %q
+			}
+		},
+	]
+}
+`, filePath, write)
+	}
+
+	// Test cases for data source permissions resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("Allow"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource_permissions.uat_terraform_provider_test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("tableau_datasource_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Read", "Allow"),
+					resource.TestCheckResourceAttr("tableau_datasource_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Write", "Allow"),
+					resource.TestCheckResourceAttrPair("tableau_datasource_permissions.uat_terraform_provider_test", "datasource_id", "tableau_datasource.uat_terraform_provider_test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "tableau_datasource_permissions.uat_terraform_provider_test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "datasource_id",
+				ImportStateIdFunc:                    testAccImportStateAttribute("tableau_datasource_permissions.uat_terraform_provider_test", "datasource_id"),
+			},
+			// Update and Read testing
+			{
+				Config: config("Deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Write", "Deny"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
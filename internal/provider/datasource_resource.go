@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &datasourceResource{}
+	_ resource.ResourceWithConfigure   = &datasourceResource{}
+	_ resource.ResourceWithImportState = &datasourceResource{}
+	_ resource.ResourceWithModifyPlan  = &datasourceResource{}
+)
+
+type datasourceResource struct {
+	client *client.TableauClient
+}
+
+type datasourceResourceModel struct {
+	ID          types.String                `tfsdk:"id"`
+	Name        types.String                `tfsdk:"name"`
+	ProjectID   types.String                `tfsdk:"project_id"`
+	FilePath    types.String                `tfsdk:"file_path"`
+	ContentHash types.String                `tfsdk:"content_hash"`
+	Description types.String                `tfsdk:"description"`
+	Tags        types.Set                   `tfsdk:"tags"`
+	Credentials *datasourceCredentialsModel `tfsdk:"credentials"`
+	Overwrite   types.Bool                  `tfsdk:"overwrite"`
+	Type        types.String                `tfsdk:"type"`
+	ContentUrl  types.String                `tfsdk:"content_url"`
+	WebpageUrl  types.String                `tfsdk:"webpage_url"`
+}
+
+type datasourceCredentialsModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Embed    types.Bool   `tfsdk:"embed"`
+}
+
+func NewDatasourceResource() resource.Resource {
+	return &datasourceResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *datasourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasource"
+}
+
+// Schema defines the schema for the resource.
+func (r *datasourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publish a data source file to a project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Data source ID",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Data source name, unique in the project",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project the data source is published to",
+			},
+			"file_path": schema.StringAttribute{
+				Required: true,
+				Description: "Path of the .tds, .tdsx or .hyper file to publish. " +
+					"Files larger than 8 MB are uploaded in chunks before being published",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed: true,
+				Description: "SHA-256 hash of the content of the file, the data source is published again whenever it changes. " +
+					"Null when the data source was imported, until the file is published again on the next apply",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Data source description",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Description: "Tags of the data source, any other tag added on Tableau is removed",
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Credentials of the connection of the data source to its database, published along with the file. " +
+					"The data source is published again whenever they change",
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Required:    true,
+						Description: "Username of the database connection",
+					},
+					"password": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "Password of the database connection",
+					},
+					"embed": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: "Whether the credentials are embedded in the data source, rather than prompted to its users. Defaults to `true`",
+					},
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether creating the resource replaces a data source with the same name in the project, rather than failing. " +
+					"Changes of the file always overwrite the data source. Defaults to `false`",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the connection of the data source, e.g. `hyper` or `postgres`",
+			},
+			"content_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the data source in URLs",
+			},
+			"webpage_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the data source on Tableau",
+			},
+		},
+	}
+}
+
+// ModifyPlan plans the hash of the content of the file, so that changes of the
+// file are planned as an update that publishes the data source again.
+func (r *datasourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planFileContentHash(ctx, req, resp)
+}
+
+// Create a new resource.
+func (r *datasourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan datasourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Publish and tag data source
+	datasource, err := r.publisher().create(ctx, func(ctx context.Context) (*client.Datasource, error) {
+		return r.client.PublishDatasource(ctx, plan.FilePath.ValueString(), r.expandDatasource(plan), plan.Overwrite.ValueBool())
+	}, tags)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Tableau Data Source",
+			err.Error(),
+		)
+		if datasource != nil {
+			// Record the published data source, so that it gets replaced
+			// rather than published again next to itself
+			setDatasourceResourceModel(&plan, datasource)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+		return
+	}
+
+	// Set ID and computed values
+	setDatasourceResourceModel(&plan, datasource)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *datasourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state datasourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	datasource, err := r.client.GetDatasource(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// Data source was deleted outside of Terraform, so it must be published again
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Data Source",
+			"Could not read Tableau data source ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state, the file and credentials can't be
+	// read back
+	setDatasourceResourceModel(&state, datasource)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *datasourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan datasourceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state datasourceResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rename and move the data source first, so that publishing the file
+	// again overwrites it under its new name and project
+	if !plan.Name.Equal(state.Name) || !plan.ProjectID.Equal(state.ProjectID) || !plan.Description.Equal(state.Description) {
+		_, err := r.client.UpdateDatasource(
+			ctx,
+			plan.ID.ValueString(),
+			plan.Name.ValueString(),
+			plan.Description.ValueString(),
+			plan.ProjectID.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Tableau Data Source",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Credentials are only accepted when publishing, so publish the file
+	// again when either changed
+	var publish func(ctx context.Context) (*client.Datasource, error)
+	if !plan.ContentHash.Equal(state.ContentHash) || !plan.Credentials.equal(state.Credentials) {
+		publish = func(ctx context.Context) (*client.Datasource, error) {
+			return r.client.PublishDatasource(ctx, plan.FilePath.ValueString(), r.expandDatasource(plan), true)
+		}
+	}
+
+	datasource, err := r.publisher().update(ctx, plan.ID.ValueString(), publish, tags)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Tableau Data Source",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	setDatasourceResourceModel(&plan, datasource)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *datasourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state datasourceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete data source
+	err := r.client.DeleteDatasource(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// If the data source is already deleted, we can ignore the error
+			resp.Diagnostics.AddWarning(
+				"Unable to Delete Tableau Data Source",
+				err.Error(),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau Data Source",
+				err.Error(),
+			)
+		}
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *datasourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *datasourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandDatasource returns the data source described by the model, as
+// published.
+func (r *datasourceResource) expandDatasource(model datasourceResourceModel) client.Datasource {
+	datasource := client.Datasource{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Project:     &client.ContentProject{ID: model.ProjectID.ValueString()},
+	}
+	if model.Credentials != nil {
+		datasource.ConnectionCredentials = &client.ConnectionCredentials{
+			Name:     model.Credentials.Username.ValueString(),
+			Password: model.Credentials.Password.ValueString(),
+			Embed:    client.FlexBool(model.Credentials.Embed.ValueBool()),
+		}
+	}
+
+	return datasource
+}
+
+// publisher publishes and tags data sources.
+func (r *datasourceResource) publisher() contentPublisher[*client.Datasource] {
+	return contentPublisher[*client.Datasource]{
+		client:      r.client,
+		contentType: client.ContentTypeDatasources,
+		get:         r.client.GetDatasource,
+		id:          func(datasource *client.Datasource) string { return datasource.ID },
+	}
+}
+
+// equal reports whether both credentials are the same, including when both
+// are unset.
+func (m *datasourceCredentialsModel) equal(other *datasourceCredentialsModel) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+
+	return m.Username.Equal(other.Username) && m.Password.Equal(other.Password) && m.Embed.Equal(other.Embed)
+}
+
+func setDatasourceResourceModel(model *datasourceResourceModel, datasource *client.Datasource) {
+	model.ID = types.StringValue(datasource.ID)
+	model.Name = types.StringValue(datasource.Name)
+	model.ProjectID = types.StringValue(datasource.ProjectID())
+	model.Description = types.StringValue(datasource.Description)
+	model.Tags = tagsValue(datasource.TagLabels())
+	model.Type = types.StringValue(datasource.Type)
+	model.ContentUrl = types.StringValue(datasource.ContentUrl)
+	model.WebpageUrl = types.StringValue(datasource.WebpageUrl)
+}
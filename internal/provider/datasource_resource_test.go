@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccDatasource is a data source with a live connection to a PostgreSQL
+// database.
+const testAccDatasource = `<?xml version='1.0' encoding='utf-8' ?>
+<datasource formatted-name='%s' inline='true' version='18.1' xmlns:user='http://www.tableausoftware.com/xml/user'>
+  <connection class='postgres' dbname='orders' port='5432' server='db.example.com' username='tableau_reader' />
+</datasource>
+`
+
+func writeTestAccDatasource(t *testing.T, name string) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), "datasource.tds")
+	err := os.WriteFile(filePath, []byte(fmt.Sprintf(testAccDatasource, name)), 0o600)
+	if err != nil {
+		t.Fatalf("writing data source file: %s", err)
+	}
+
+	return filePath
+}
+
+func TestAccDatasourceResource(t *testing.T) {
+	filePath := writeTestAccDatasource(t, "orders")
+	updatedFilePath := writeTestAccDatasource(t, "orders-updated")
+
+	// Test cases for data source resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-datasources"
+}
+
+resource "tableau_datasource" "uat_terraform_provider_test" {
+	name        = "uat-terraform-provider-test"
+	project_id  = tableau_project.uat_terraform_provider_test.id
+	file_path   = %q
+	description = "UAT - terraform provider test"
+	tags        = ["uat", "terraform"]
+
+	credentials = {
+		username = "tableau_reader"
+		password = "uat-password"
+		embed    = false
+	}
+}
+`, filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "name", "uat-terraform-provider-test"),
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "description", "UAT - terraform provider test"),
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "credentials.embed", "false"),
+					resource.TestCheckResourceAttrPair("tableau_datasource.uat_terraform_provider_test", "project_id", "tableau_project.uat_terraform_provider_test", "id"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_datasource.uat_terraform_provider_test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_datasource.uat_terraform_provider_test", "content_hash"),
+					resource.TestCheckResourceAttrSet("tableau_datasource.uat_terraform_provider_test", "type"),
+					resource.TestCheckResourceAttrSet("tableau_datasource.uat_terraform_provider_test", "webpage_url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_datasource.uat_terraform_provider_test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "content_hash", "credentials", "overwrite"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-datasources"
+}
+
+resource "tableau_datasource" "uat_terraform_provider_test" {
+	name       = "uat-terraform-provider-test-updated"
+	project_id = tableau_project.uat_terraform_provider_test.id
+	file_path  = %q
+	tags       = ["uat"]
+}
+`, updatedFilePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "name", "uat-terraform-provider-test-updated"),
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "description", ""),
+					resource.TestCheckResourceAttr("tableau_datasource.uat_terraform_provider_test", "tags.#", "1"),
+					resource.TestCheckNoResourceAttr("tableau_datasource.uat_terraform_provider_test", "credentials.username"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
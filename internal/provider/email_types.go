@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Tableau matches user emails regardless of case, while identity providers
+// may send them with any casing. The email types below consider values that
+// only differ by case as equal, so that such differences never show as drift.
+
+var (
+	_ basetypes.StringTypable                    = emailType{}
+	_ basetypes.StringValuableWithSemanticEquals = emailValue{}
+	_ basetypes.SetTypable                       = emailSetType{}
+	_ basetypes.SetValuableWithSemanticEquals    = emailSetValue{}
+)
+
+// emailType is a string type holding a user email.
+type emailType struct {
+	basetypes.StringType
+}
+
+func (t emailType) Equal(o attr.Type) bool {
+	other, ok := o.(emailType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t emailType) String() string {
+	return "emailType"
+}
+
+func (t emailType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return emailValue{StringValue: in}, nil
+}
+
+func (t emailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return emailValue{StringValue: stringValue}, nil
+}
+
+func (t emailType) ValueType(_ context.Context) attr.Value {
+	return emailValue{}
+}
+
+// emailValue is a user email, equal to any other casing of the same email.
+type emailValue struct {
+	basetypes.StringValue
+}
+
+func newEmailValue(email string) emailValue {
+	return emailValue{StringValue: types.StringValue(email)}
+}
+
+func (v emailValue) Equal(o attr.Value) bool {
+	other, ok := o.(emailValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v emailValue) Type(_ context.Context) attr.Type {
+	return emailType{}
+}
+
+func (v emailValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(emailValue)
+	if !ok {
+		return false, nil
+	}
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return false, nil
+	}
+
+	return strings.EqualFold(v.ValueString(), newValue.ValueString()), nil
+}
+
+// emailSetType is a set type holding user emails.
+type emailSetType struct {
+	basetypes.SetType
+}
+
+func newEmailSetType() emailSetType {
+	return emailSetType{SetType: basetypes.SetType{ElemType: types.StringType}}
+}
+
+func (t emailSetType) Equal(o attr.Type) bool {
+	other, ok := o.(emailSetType)
+	if !ok {
+		return false
+	}
+
+	return t.SetType.Equal(other.SetType)
+}
+
+func (t emailSetType) String() string {
+	return "emailSetType"
+}
+
+func (t emailSetType) ValueFromSet(_ context.Context, in basetypes.SetValue) (basetypes.SetValuable, diag.Diagnostics) {
+	return emailSetValue{SetValue: in}, nil
+}
+
+func (t emailSetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.SetType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	setValue, ok := attrValue.(basetypes.SetValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return emailSetValue{SetValue: setValue}, nil
+}
+
+func (t emailSetType) ValueType(_ context.Context) attr.Value {
+	return emailSetValue{}
+}
+
+// emailSetValue is a set of user emails, equal to any other set holding the
+// same emails with different casings.
+type emailSetValue struct {
+	basetypes.SetValue
+}
+
+func newEmailSetNull() emailSetValue {
+	return emailSetValue{SetValue: types.SetNull(types.StringType)}
+}
+
+func newEmailSetValue(emails []attr.Value) emailSetValue {
+	// SetValueMust will prevent empty list to be set as null
+	return emailSetValue{SetValue: types.SetValueMust(types.StringType, emails)}
+}
+
+func (v emailSetValue) Equal(o attr.Value) bool {
+	other, ok := o.(emailSetValue)
+	if !ok {
+		return false
+	}
+
+	return v.SetValue.Equal(other.SetValue)
+}
+
+func (v emailSetValue) Type(_ context.Context) attr.Type {
+	return newEmailSetType()
+}
+
+func (v emailSetValue) SetSemanticEquals(ctx context.Context, newValuable basetypes.SetValuable) (bool, diag.Diagnostics) {
+	newValue, diags := newValuable.ToSetValue(ctx)
+	if diags.HasError() {
+		return false, diags
+	}
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return false, diags
+	}
+
+	var emails, newEmails []string
+	diags.Append(v.ElementsAs(ctx, &emails, false)...)
+	diags.Append(newValue.ElementsAs(ctx, &newEmails, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	folded, newFolded := foldEmails(emails), foldEmails(newEmails)
+	if len(folded) != len(newFolded) {
+		return false, diags
+	}
+	for email := range folded {
+		if !newFolded[email] {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// foldEmails returns the set of the lower cased emails.
+func foldEmails(emails []string) map[string]bool {
+	folded := map[string]bool{}
+	for _, email := range emails {
+		folded[strings.ToLower(email)] = true
+	}
+
+	return folded
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestEmailValueStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    emailValue
+		newValue basetypes.StringValuable
+		expected bool
+	}{
+		{name: "same email", value: newEmailValue("user@example.com"), newValue: newEmailValue("user@example.com"), expected: true},
+		{name: "different case", value: newEmailValue("User@Example.com"), newValue: newEmailValue("user@example.COM"), expected: true},
+		{name: "different email", value: newEmailValue("user@example.com"), newValue: newEmailValue("other@example.com"), expected: false},
+		{name: "null", value: emailValue{StringValue: types.StringNull()}, newValue: newEmailValue(""), expected: false},
+		{name: "new null", value: newEmailValue(""), newValue: emailValue{StringValue: types.StringNull()}, expected: false},
+		{name: "unknown", value: emailValue{StringValue: types.StringUnknown()}, newValue: newEmailValue("user@example.com"), expected: false},
+		{name: "plain string", value: newEmailValue("user@example.com"), newValue: types.StringValue("user@example.com"), expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			equal, diags := test.value.StringSemanticEquals(context.Background(), test.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
+
+func TestEmailSetValueSetSemanticEquals(t *testing.T) {
+	emails := func(emails ...string) emailSetValue {
+		values := make([]attr.Value, len(emails))
+		for i, email := range emails {
+			values[i] = types.StringValue(email)
+		}
+		return newEmailSetValue(values)
+	}
+
+	tests := []struct {
+		name     string
+		value    emailSetValue
+		newValue emailSetValue
+		expected bool
+	}{
+		{name: "same emails", value: emails("a@example.com", "b@example.com"), newValue: emails("b@example.com", "a@example.com"), expected: true},
+		{name: "different case", value: emails("A@Example.com", "b@example.com"), newValue: emails("a@example.com", "B@EXAMPLE.COM"), expected: true},
+		{name: "both empty", value: emails(), newValue: emails(), expected: true},
+		{name: "different email", value: emails("a@example.com", "b@example.com"), newValue: emails("a@example.com", "c@example.com"), expected: false},
+		{name: "additional email", value: emails("a@example.com"), newValue: emails("a@example.com", "b@example.com"), expected: false},
+		{name: "null", value: newEmailSetNull(), newValue: emails(), expected: false},
+		{name: "new null", value: emails(), newValue: newEmailSetNull(), expected: false},
+		{name: "unknown", value: emailSetValue{SetValue: types.SetUnknown(types.StringType)}, newValue: emails("a@example.com"), expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			equal, diags := test.value.SetSemanticEquals(context.Background(), test.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, equal)
+			}
+		})
+	}
+}
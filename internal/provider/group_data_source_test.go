@@ -28,3 +28,30 @@ data "tableau_group" "uat_terraform_provider_test" {
 		},
 	})
 }
+
+func TestAccGroupsDataSource(t *testing.T) {
+	// Test cases for groups data source
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "UAT - terraform provider test"
+}
+
+data "tableau_groups" "uat_terraform_provider_test" {
+	filter      = "isLocal:eq:true"
+	name_prefix = resource.tableau_group.uat_terraform_provider_test.name
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tableau_groups.uat_terraform_provider_test", "groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.tableau_groups.uat_terraform_provider_test", "groups.0.id", "tableau_group.uat_terraform_provider_test", "id"),
+					resource.TestCheckResourceAttr("data.tableau_groups.uat_terraform_provider_test", "groups.0.is_local", "true"),
+					resource.TestCheckResourceAttr("data.tableau_groups.uat_terraform_provider_test", "groups.0.user_count", "0"),
+				),
+			},
+		},
+	})
+}
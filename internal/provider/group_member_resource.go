@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &groupMemberResource{}
+	_ resource.ResourceWithConfigure   = &groupMemberResource{}
+	_ resource.ResourceWithImportState = &groupMemberResource{}
+)
+
+type groupMemberResource struct {
+	client *client.TableauClient
+}
+
+type groupMemberResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	GroupID types.String `tfsdk:"group_id"`
+	UserID  types.String `tfsdk:"user_id"`
+}
+
+func NewGroupMemberResource() resource.Resource {
+	return &groupMemberResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *groupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a single user to a group, leaving the other members of the group untouched. " +
+			"Do not combine with `tableau_group_membership` on the same group, which removes members it does not list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Group member ID, in the form `group_id/user_id`",
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Group id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "User id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *groupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan groupMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add user to group
+	err := r.client.CreateGroupMembershipByUserID(
+		ctx,
+		plan.GroupID.ValueString(),
+		plan.UserID.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to add user to Tableau Group",
+			err.Error(),
+		)
+		return
+	}
+
+	// Set ID
+	plan.ID = types.StringValue(plan.GroupID.ValueString() + "/" + plan.UserID.ValueString())
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *groupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state groupMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	users, err := r.client.GetGroupUsers(ctx, state.GroupID.ValueString())
+	if client.IsNotFound(err) {
+		// Group was deleted outside of Terraform, so the member must be recreated
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Group Member",
+			"Could not read users of Tableau group "+state.GroupID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	isMember := false
+	for _, user := range users {
+		if user.ID == state.UserID.ValueString() {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		// User was removed from the group outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	state.ID = types.StringValue(state.GroupID.ValueString() + "/" + state.UserID.ValueString())
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called since every attribute requires replacement.
+func (r *groupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Unable to Update Tableau Group Member",
+		"Group members cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *groupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state groupMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove user from group
+	err := r.client.DeleteGroupMembershipByUserID(ctx, state.GroupID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// If the user, the group or the membership is already gone, we can ignore the error
+			resp.Diagnostics.AddWarning(
+				"Unable to delete user from Tableau Group",
+				err.Error(),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to delete user from Tableau Group",
+				err.Error(),
+			)
+		}
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *groupMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *groupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Split import ID into group and user IDs
+	ids := strings.Split(req.ID, "/")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: group_id/user_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), ids[1])...)
+}
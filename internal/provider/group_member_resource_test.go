@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupMemberResource(t *testing.T) {
+	// Test cases for group member resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_user" "uat_test" {
+	email 		 = "uat_test@example.com"
+	site_role 	 = "Unlicensed"
+	auth_setting = "OpenID"
+}
+
+resource "tableau_group_member" "uat_test_group_member" {
+	group_id = tableau_group.uat_terraform_provider_test.id
+	user_id  = tableau_user.uat_test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("tableau_group_member.uat_test_group_member", "group_id", "tableau_group.uat_terraform_provider_test", "id"),
+					resource.TestCheckResourceAttrPair("tableau_group_member.uat_test_group_member", "user_id", "tableau_user.uat_test", "id"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_group_member.uat_test_group_member", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "tableau_group_member.uat_test_group_member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -6,11 +6,15 @@ import (
 	"terraform-provider-tableau/internal/client"
 	"terraform-provider-tableau/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,8 +29,70 @@ type groupMembershipResource struct {
 }
 
 type groupMembershipResourceModel struct {
-	GroupID    types.String `tfsdk:"group_id"`
-	UserEmails types.Set    `tfsdk:"users"`
+	GroupID    types.String  `tfsdk:"group_id"`
+	UserEmails emailSetValue `tfsdk:"users"`
+	UserIDs    types.Set     `tfsdk:"user_ids"`
+}
+
+// byUserID reports whether the members are given as user IDs rather than
+// emails.
+func (m groupMembershipResourceModel) byUserID() bool {
+	return !m.UserIDs.IsNull()
+}
+
+// members returns the user IDs or emails of the members, depending on which
+// attribute is set.
+func (m groupMembershipResourceModel) members(ctx context.Context) ([]string, diag.Diagnostics) {
+	var members []string
+	if m.byUserID() {
+		diags := m.UserIDs.ElementsAs(ctx, &members, false)
+		return members, diags
+	}
+	diags := m.UserEmails.ElementsAs(ctx, &members, false)
+	return members, diags
+}
+
+// setMembers overwrites the members with the given users, keeping the
+// attribute already in use.
+func (m *groupMembershipResourceModel) setMembers(users []client.User) {
+	var members []string
+	for _, user := range users {
+		members = append(members, m.memberKey(user))
+	}
+	m.setMemberKeys(members)
+}
+
+// setMemberKeys overwrites the members with the given user IDs or emails,
+// depending on the attribute already in use.
+func (m *groupMembershipResourceModel) setMemberKeys(members []string) {
+	values := []attr.Value{}
+	for _, member := range members {
+		values = append(values, types.StringValue(member))
+	}
+
+	// SetValueMust will prevent empty list to be set as null
+	if m.byUserID() {
+		m.UserIDs = types.SetValueMust(types.StringType, values)
+	} else {
+		m.UserEmails = newEmailSetValue(values)
+	}
+}
+
+// hasMember reports whether member is one of members, comparing emails
+// regardless of case.
+func (m groupMembershipResourceModel) hasMember(members []string, member string) bool {
+	if m.byUserID() {
+		return utils.StringInSlice(member, members)
+	}
+	return utils.StringInSliceFold(member, members)
+}
+
+// memberKey returns the user ID or email identifying the user in the model.
+func (m groupMembershipResourceModel) memberKey(user client.User) string {
+	if m.byUserID() {
+		return user.ID
+	}
+	return user.Email
 }
 
 func NewGroupMembershipResource() resource.Resource {
@@ -50,8 +116,17 @@ func (r *groupMembershipResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"users": schema.SetAttribute{
-				Required:    true,
-				Description: "List of user emails",
+				Optional:    true,
+				CustomType:  newEmailSetType(),
+				Description: "List of user emails, compared regardless of case. Exactly one of `users` or `user_ids` must be set",
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("user_ids")),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Optional:    true,
+				Description: "List of user ids. Exactly one of `users` or `user_ids` must be set",
 				ElementType: types.StringType,
 			},
 		},
@@ -69,26 +144,46 @@ func (r *groupMembershipResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Parse plan tf list types to go list/slice types
-	var userEmails []string
-	diags = plan.UserEmails.ElementsAs(ctx, &userEmails, false)
+	members, diags := plan.members(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Add users to group
-	for _, email := range userEmails {
-		err := r.client.CreateGroupMembershipByUserEmail(
-			plan.GroupID.ValueString(),
-			email,
+	addMember, _, err := r.memberFuncs(ctx, plan, members)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to add user to Tableau Group",
+			"Could not list Tableau users: "+err.Error(),
 		)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to add user to Tableau Group",
-				err.Error(),
-			)
+		return
+	}
+	errs := r.client.ForEachConcurrently(ctx, len(members), func(ctx context.Context, i int) error {
+		return addMember(ctx, plan.GroupID.ValueString(), members[i])
+	})
+	added, failed := splitByError(members, errs)
+	if len(failed) > 0 {
+		// When some users were added, the membership is created with them and
+		// the failed users are reported as warnings rather than errors, so that
+		// Terraform doesn't taint it and the next apply only adds the missing
+		// users. When none were added, there is nothing to record.
+		report := resp.Diagnostics.AddWarning
+		if len(added) == 0 {
+			report = resp.Diagnostics.AddError
+		}
+		for i, err := range errs {
+			if err != nil {
+				report(
+					"Unable to add user to Tableau Group",
+					fmt.Sprintf("Could not add user %s: %s", members[i], err),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
 			return
 		}
+		plan.setMemberKeys(added)
 	}
 
 	// Set state
@@ -110,9 +205,14 @@ func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get refreshed values
-	groupMembershipEmailList, err := r.client.GetGroupMembership(state.GroupID.ValueString())
+	users, err := r.client.GetGroupUsers(ctx, state.GroupID.ValueString())
+	if client.IsNotFound(err) {
+		// Group was deleted outside of Terraform, so the membership must be recreated
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddWarning(
+		resp.Diagnostics.AddError(
 			"Error Reading Tableau Group Membership",
 			"Could not read Tableau Group Membership"+": "+err.Error(),
 		)
@@ -120,14 +220,7 @@ func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Overwrite items with refreshed state
-	state.GroupID = types.StringValue(groupMembershipEmailList.GroupID)
-	state.UserEmails, diags = types.SetValueFrom(ctx, types.StringType, groupMembershipEmailList.UserEmails)
-	// SetValueMust will prevent empty list to be set as null
-	state.UserEmails = types.SetValueMust(types.StringType, state.UserEmails.Elements())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	state.setMembers(users)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -148,15 +241,14 @@ func (r *groupMembershipResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Parse plan tf list types to go list/slice types
-	var userEmails []string
-	diags = plan.UserEmails.ElementsAs(ctx, &userEmails, false)
+	members, diags := plan.members(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Get actual values
-	groupMembershipEmailList, err := r.client.GetGroupMembership(plan.GroupID.ValueString())
+	users, err := r.client.GetGroupUsers(ctx, plan.GroupID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Group Membership",
@@ -165,39 +257,79 @@ func (r *groupMembershipResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Delete user if groupMembershipEmailList.UserEmails is not in plan.UserEmails
-	for _, email := range groupMembershipEmailList.UserEmails {
-		if !utils.StringInSlice(email, userEmails) {
-			err = r.client.DeleteGroupMembershipByUserEmail(plan.GroupID.ValueString(), email)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Unable to delete user from Tableau Group",
-					err.Error(),
-				)
-				return
-			}
+	// Find users that are not in the planned members
+	var currentMembers []string
+	var removedUsers []client.User
+	for _, user := range users {
+		currentMembers = append(currentMembers, plan.memberKey(user))
+		if !plan.hasMember(members, plan.memberKey(user)) {
+			removedUsers = append(removedUsers, user)
 		}
 	}
 
-	// Add user if plan.UserEmails is not in groupMembershipEmailList.UserEmails
-	for _, email := range userEmails {
-		if !utils.StringInSlice(email, groupMembershipEmailList.UserEmails) {
-			err = r.client.CreateGroupMembershipByUserEmail(
-				plan.GroupID.ValueString(),
-				email,
+	// Find planned members that are not in the group
+	var newMembers []string
+	for _, member := range members {
+		if !plan.hasMember(currentMembers, member) {
+			newMembers = append(newMembers, member)
+		}
+	}
+	addMember, _, err := r.memberFuncs(ctx, plan, newMembers)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to add user to Tableau Group",
+			"Could not list Tableau users: "+err.Error(),
+		)
+		return
+	}
+
+	// Removals and additions concern different users, so they all run at once
+	errs := r.client.ForEachConcurrently(ctx, len(removedUsers)+len(newMembers), func(ctx context.Context, i int) error {
+		if i < len(removedUsers) {
+			return r.client.DeleteGroupMembershipByUserID(ctx, plan.GroupID.ValueString(), removedUsers[i].ID)
+		}
+		return addMember(ctx, plan.GroupID.ValueString(), newMembers[i-len(removedUsers)])
+	})
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if i < len(removedUsers) {
+			resp.Diagnostics.AddError(
+				"Unable to delete user from Tableau Group",
+				fmt.Sprintf("Could not delete user %s: %s", plan.memberKey(removedUsers[i]), err),
 			)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Unable to add user to Tableau Group",
-					err.Error(),
-				)
-				return
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to add user to Tableau Group",
+				fmt.Sprintf("Could not add user %s: %s", newMembers[i-len(removedUsers)], err),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		// Record exactly the changes that succeeded, so that the next apply
+		// only retries the failed ones
+		deletedUsers, _ := splitByError(removedUsers, errs[:len(removedUsers)])
+		addedMembers, _ := splitByError(newMembers, errs[len(removedUsers):])
+		var removedMembers, remainingMembers []string
+		for _, user := range deletedUsers {
+			removedMembers = append(removedMembers, plan.memberKey(user))
+		}
+		for _, member := range currentMembers {
+			if !plan.hasMember(removedMembers, member) {
+				remainingMembers = append(remainingMembers, member)
 			}
 		}
+		plan.setMemberKeys(append(remainingMembers, addedMembers...))
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
 	// Get updated values
-	updatedGroupMembershipEmailList, err := r.client.GetGroupMembership(plan.GroupID.ValueString())
+	updatedUsers, err := r.client.GetGroupUsers(ctx, plan.GroupID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Tableau Group Membership",
@@ -207,14 +339,7 @@ func (r *groupMembershipResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Update resource state with updated values
-	plan.GroupID = types.StringValue(updatedGroupMembershipEmailList.GroupID)
-	plan.UserEmails, diags = types.SetValueFrom(ctx, types.StringType, updatedGroupMembershipEmailList.UserEmails)
-	// SetValueMust will prevent empty list to be set as null
-	plan.UserEmails = types.SetValueMust(types.StringType, plan.UserEmails.Elements())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	plan.setMembers(updatedUsers)
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -235,20 +360,90 @@ func (r *groupMembershipResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Parse plan tf list types to go list/slice types
-	var userEmails []string
-	state.UserEmails.ElementsAs(ctx, &userEmails, false)
+	members, diags := state.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Delete users from group
-	for _, email := range userEmails {
-		err := r.client.DeleteGroupMembershipByUserEmail(state.GroupID.ValueString(), email)
+	_, removeMember, err := r.memberFuncs(ctx, state, members)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete user from Tableau Group",
+			"Could not list Tableau users: "+err.Error(),
+		)
+		return
+	}
+	errs := r.client.ForEachConcurrently(ctx, len(members), func(ctx context.Context, i int) error {
+		return removeMember(ctx, state.GroupID.ValueString(), members[i])
+	})
+	for i, err := range errs {
+		if client.IsNotFound(err) {
+			// User or group is already gone, nothing left to delete
+			errs[i] = nil
+			continue
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to delete user from Tableau Group",
-				err.Error(),
+				fmt.Sprintf("Could not delete user %s: %s", members[i], err),
 			)
-			return
 		}
 	}
+	if resp.Diagnostics.HasError() {
+		// Keep only the users that could not be deleted in state, so that the
+		// next destroy only retries them
+		_, remaining := splitByError(members, errs)
+		state.setMemberKeys(remaining)
+
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// splitByError splits items between those whose error is nil and the others.
+func splitByError[T any](items []T, errs []error) (succeeded []T, failed []T) {
+	for i, item := range items {
+		if errs[i] != nil {
+			failed = append(failed, item)
+		} else {
+			succeeded = append(succeeded, item)
+		}
+	}
+
+	return succeeded, failed
+}
+
+// memberFunc adds or removes a member of a group.
+type memberFunc func(ctx context.Context, groupID string, member string) error
+
+// memberFuncs returns the client methods adding and removing a member given as
+// it is in the model, either by user ID or by email. Emails are resolved with
+// a single listing of the site's users, which is skipped when there are no
+// members to resolve.
+func (r *groupMembershipResource) memberFuncs(ctx context.Context, model groupMembershipResourceModel, members []string) (memberFunc, memberFunc, error) {
+	if model.byUserID() {
+		return r.client.CreateGroupMembershipByUserID, r.client.DeleteGroupMembershipByUserID, nil
+	}
+
+	var users *client.UserIndex
+	if len(members) > 0 {
+		var err error
+		users, err = r.client.NewUserIndex(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	add := func(ctx context.Context, groupID string, userEmail string) error {
+		return r.client.CreateGroupMembershipByUserEmail(ctx, users, groupID, userEmail)
+	}
+	remove := func(ctx context.Context, groupID string, userEmail string) error {
+		return r.client.DeleteGroupMembershipByUserEmail(ctx, users, groupID, userEmail)
+	}
+
+	return add, remove, nil
 }
 
 // Configure adds the provider configured client to the resource.
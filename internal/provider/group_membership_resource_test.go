@@ -1,8 +1,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-tableau/internal/client"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -52,3 +61,119 @@ resource "tableau_group_membership" "uat_test_group_membership" {
 		},
 	})
 }
+
+func TestAccGroupMembershipResourceByUserID(t *testing.T) {
+	// Test cases for group membership by user id
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_user" "uat_test" {
+	email 		 = "uat_test@example.com"
+	site_role 	 = "Unlicensed"
+	auth_setting = "OpenID"
+}
+
+resource "tableau_group_membership" "uat_test_group_membership" {
+	group_id = tableau_group.uat_terraform_provider_test.id
+	user_ids = [
+		tableau_user.uat_test.id,
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("tableau_group_membership.uat_test_group_membership", "user_ids.*", "tableau_user.uat_test", "id"),
+					resource.TestCheckNoResourceAttr("tableau_group_membership.uat_test_group_membership", "users"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_group_membership.uat_test_group_membership", "group_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestGroupMembershipResourceCreateKeepsAddedUsers(t *testing.T) {
+	// Fake Tableau server failing to add user-2 to the group
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/3.18/auth/signin":
+			fmt.Fprint(w, `{"credentials":{"site":{"id":"site-id"},"token":"token","estimatedTimeToExpiration":"240:00:00"}}`)
+		case "/api/3.18/sites/site-id/groups/group-id/users":
+			var membership client.GroupMembershipRequest
+			if err := json.NewDecoder(r.Body).Decode(&membership); err != nil {
+				t.Errorf("decoding request body: %s", err)
+			}
+			if membership.User.ID == "user-2" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":{"code":"400000","summary":"Bad Request","detail":"Invalid user"}}`)
+				return
+			}
+			fmt.Fprintf(w, `{"user":{"id":%q}}`, membership.User.ID)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	retryPolicy := client.DefaultRetryPolicy()
+	retryPolicy.MinBackoff = 0
+	retryPolicy.MaxBackoff = 0
+	c, err := client.NewTableauClient(ctx, server.URL, "3.18", "site", "name", "secret", retryPolicy, client.DefaultMaxConcurrency, client.DefaultUploadTimeout)
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+	r := &groupMembershipResource{client: c}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	setType := tftypes.Set{ElementType: tftypes.String}
+	req := fwresource.CreateRequest{
+		Plan: tfsdk.Plan{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"group_id": tftypes.NewValue(tftypes.String, "group-id"),
+				"users":    tftypes.NewValue(setType, nil),
+				"user_ids": tftypes.NewValue(setType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "user-1"),
+					tftypes.NewValue(tftypes.String, "user-2"),
+				}),
+			}),
+		},
+	}
+	resp := fwresource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	r.Create(ctx, req, &resp)
+
+	// The failed user is a warning, so that the membership isn't tainted
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if count := resp.Diagnostics.WarningsCount(); count != 1 {
+		t.Errorf("expected 1 warning, got %d: %v", count, resp.Diagnostics.Warnings())
+	}
+	var state groupMembershipResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading state: %v", resp.Diagnostics.Errors())
+	}
+	var userIDs []string
+	state.UserIDs.ElementsAs(ctx, &userIDs, false)
+	if len(userIDs) != 1 || userIDs[0] != "user-1" {
+		t.Errorf("expected user_ids [user-1] in state, got %v", userIDs)
+	}
+}
@@ -69,8 +69,16 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Create group
 	group, err := r.client.CreateGroup(
+		ctx,
 		plan.Name.ValueString(),
 	)
+	if client.IsConflict(err) {
+		resp.Diagnostics.AddError(
+			"Tableau Group Already Exists",
+			fmt.Sprintf("A group named %q already exists. Import it with terraform import to manage it with Terraform: %s", plan.Name.ValueString(), err),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Tableau Group",
@@ -106,7 +114,12 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	groupID := state.ID.ValueString()
 	if strings.HasPrefix(groupID, "name/") {
 		groupName := strings.Split(groupID, "/")[1]
-		group, err = r.client.GetGroupByName(groupName)
+		group, err = r.client.GetGroupByName(ctx, groupName)
+		if client.IsNotFound(err) {
+			// Group was deleted outside of Terraform, so it must be recreated
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Tableau Group with Name",
@@ -116,7 +129,12 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	} else {
 		// Get refreshed values
-		group, err = r.client.GetGroupByID(groupID)
+		group, err = r.client.GetGroupByID(ctx, groupID)
+		if client.IsNotFound(err) {
+			// Group was deleted outside of Terraform, so it must be recreated
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Tableau Group with ID",
@@ -150,6 +168,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Update group
 	_, err := r.client.UpdateGroup(
+		ctx,
 		plan.ID.ValueString(),
 		plan.Name.ValueString(),
 	)
@@ -162,7 +181,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Fetch updated group from server
-	updatedGroup, err := r.client.GetGroupByName(plan.Name.ValueString())
+	updatedGroup, err := r.client.GetGroupByName(ctx, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau Group",
@@ -194,9 +213,9 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete group
-	err := r.client.DeleteGroup(state.ID.ValueString())
+	err := r.client.DeleteGroup(ctx, state.ID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			// If the group is already deleted, we can ignore the error
 			resp.Diagnostics.AddWarning(
 				"Unable to Delete Tableau Group",
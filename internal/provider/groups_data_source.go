@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &groupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupsDataSource{}
+)
+
+type groupsDataSource struct {
+	client *client.TableauClient
+}
+
+type groupsDataSourceModel struct {
+	Filter     types.String                 `tfsdk:"filter"`
+	NamePrefix types.String                 `tfsdk:"name_prefix"`
+	Groups     []groupsDataSourceGroupModel `tfsdk:"groups"`
+}
+
+type groupsDataSourceGroupModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	DomainName       types.String `tfsdk:"domain_name"`
+	IsLocal          types.Bool   `tfsdk:"is_local"`
+	MinimumSiteRole  types.String `tfsdk:"minimum_site_role"`
+	GrantLicenseMode types.String `tfsdk:"grant_license_mode"`
+	UserCount        types.Int64  `tfsdk:"user_count"`
+}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the list of groups",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional: true,
+				Description: "Tableau filter expression, e.g. `name:in:[Finance,Sales]`, `isLocal:eq:false` or `userCount:gt:0`. " +
+					"Several expressions are combined with `,`. All groups are returned when omitted",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+				Description: "Only return the groups whose name starts with this prefix. " +
+					"Tableau can't filter groups on a name prefix, so they are matched after applying `filter`",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Groups matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the group",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Group name",
+						},
+						"domain_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the domain of the group, `local` for groups managed by Tableau",
+						},
+						"is_local": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the group is managed by Tableau, rather than imported from Active Directory or an identity provider",
+						},
+						"minimum_site_role": schema.StringAttribute{
+							Computed:    true,
+							Description: "Site role given to the members of the group when they sign in, empty if none",
+						},
+						"grant_license_mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the minimum site role is given to the members of an imported group, e.g. `onSync` or `onLogin`",
+						},
+						"user_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of users in the group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state groupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.ListGroups(ctx, state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Groups",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Groups = []groupsDataSourceGroupModel{}
+	for _, group := range groups {
+		if !strings.HasPrefix(group.Name, state.NamePrefix.ValueString()) {
+			continue
+		}
+
+		userCount, err := group.UserCount.Int64()
+		if err != nil && group.UserCount != "" {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tableau Groups",
+				fmt.Sprintf("Invalid user count of group %s: %s", group.Name, err),
+			)
+			return
+		}
+
+		grantLicenseMode := ""
+		if group.Import != nil {
+			grantLicenseMode = group.Import.GrantLicenseMode
+		}
+
+		state.Groups = append(state.Groups, groupsDataSourceGroupModel{
+			ID:               types.StringValue(group.ID),
+			Name:             types.StringValue(group.Name),
+			DomainName:       types.StringValue(group.DomainName()),
+			IsLocal:          types.BoolValue(group.IsLocal()),
+			MinimumSiteRole:  types.StringValue(group.SiteRole()),
+			GrantLicenseMode: types.StringValue(grantLicenseMode),
+			UserCount:        types.Int64Value(userCount),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// granteeCapabilitiesModel is a user or group along with the capabilities
+// allowed or denied to it.
+type granteeCapabilitiesModel struct {
+	UserID       types.String      `tfsdk:"user_id"`
+	GroupID      types.String      `tfsdk:"group_id"`
+	Capabilities map[string]string `tfsdk:"capabilities"`
+}
+
+var granteeCapabilitiesType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_id":      types.StringType,
+		"group_id":     types.StringType,
+		"capabilities": types.MapType{ElemType: types.StringType},
+	},
+}
+
+// granteeCapabilitiesAttribute is the schema of a set of grantee capabilities.
+func granteeCapabilitiesAttribute(description string, required bool) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Required:    required,
+		Optional:    !required,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"user_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "ID of the user the capabilities apply to",
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("group_id")),
+					},
+				},
+				"group_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "ID of the group the capabilities apply to",
+				},
+				"capabilities": schema.MapAttribute{
+					Required:    true,
+					ElementType: types.StringType,
+					Description: "Capability names, such as `Read`, `Write` or `ProjectLeader`, mapped to `Allow` or `Deny`",
+					Validators: []validator.Map{
+						mapvalidator.SizeAtLeast(1),
+						mapvalidator.ValueStringsAre(stringvalidator.OneOf("Allow", "Deny")),
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandPermissions converts a set of grantee capabilities to one permission
+// per capability.
+func expandPermissions(ctx context.Context, set types.Set) ([]client.Permission, diag.Diagnostics) {
+	var granteeCapabilities []granteeCapabilitiesModel
+	diags := set.ElementsAs(ctx, &granteeCapabilities, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var permissions []client.Permission
+	for _, grantee := range granteeCapabilities {
+		granteeType, granteeID := client.GranteeTypeGroup, grantee.GroupID.ValueString()
+		if !grantee.UserID.IsNull() {
+			granteeType, granteeID = client.GranteeTypeUser, grantee.UserID.ValueString()
+		}
+
+		for capability, mode := range grantee.Capabilities {
+			permissions = append(permissions, client.Permission{
+				GranteeType: granteeType,
+				GranteeID:   granteeID,
+				Capability:  capability,
+				Mode:        mode,
+			})
+		}
+	}
+
+	return permissions, diags
+}
+
+// flattenPermissions converts permissions to a set of grantee capabilities,
+// with one element per grantee.
+func flattenPermissions(ctx context.Context, permissions []client.Permission) (types.Set, diag.Diagnostics) {
+	granteeCapabilities := []granteeCapabilitiesModel{}
+	indexes := map[string]int{}
+	for _, permission := range permissions {
+		key := permission.GranteeType + "/" + permission.GranteeID
+		index, ok := indexes[key]
+		if !ok {
+			grantee := granteeCapabilitiesModel{
+				UserID:       types.StringNull(),
+				GroupID:      types.StringNull(),
+				Capabilities: map[string]string{},
+			}
+			if permission.GranteeType == client.GranteeTypeUser {
+				grantee.UserID = types.StringValue(permission.GranteeID)
+			} else {
+				grantee.GroupID = types.StringValue(permission.GranteeID)
+			}
+			granteeCapabilities = append(granteeCapabilities, grantee)
+			index = len(granteeCapabilities) - 1
+			indexes[key] = index
+		}
+
+		granteeCapabilities[index].Capabilities[permission.Capability] = permission.Mode
+	}
+
+	return types.SetValueFrom(ctx, granteeCapabilitiesType, granteeCapabilities)
+}
+
+// applyPermissions makes the current permissions match the desired ones. Extra
+// permissions are deleted before missing ones are added, so that a capability
+// can switch between Allow and Deny.
+func applyPermissions(current []client.Permission, desired []client.Permission, add func([]client.Permission) error, remove func(client.Permission) error) error {
+	toAdd, toDelete := client.DiffPermissions(current, desired)
+
+	for _, permission := range toDelete {
+		err := remove(permission)
+		if err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return add(toAdd)
+}
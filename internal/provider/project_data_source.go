@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &projectDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectDataSource{}
+)
+
+type projectDataSource struct {
+	client *client.TableauClient
+}
+
+type projectDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Path               types.String `tfsdk:"path"`
+	ParentProjectID    types.String `tfsdk:"parent_project_id"`
+	Description        types.String `tfsdk:"description"`
+	ContentPermissions types.String `tfsdk:"content_permissions"`
+	OwnerID            types.String `tfsdk:"owner_id"`
+}
+
+func newProjectDataSourceModel(project client.Project, projectPath string) projectDataSourceModel {
+	model := projectDataSourceModel{
+		ID:                 types.StringValue(project.ID),
+		Name:               types.StringValue(project.Name),
+		Path:               types.StringValue(projectPath),
+		ParentProjectID:    types.StringNull(),
+		Description:        types.StringValue(project.Description),
+		ContentPermissions: types.StringValue(project.ContentPermissions),
+		OwnerID:            types.StringValue(project.OwnerID()),
+	}
+
+	// Top level projects have no parent
+	if project.ParentProjectID != "" {
+		model.ParentProjectID = types.StringValue(project.ParentProjectID)
+	}
+
+	return model
+}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+func (d *projectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve project details by ID, name or path",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
+						path.MatchRoot("path"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project name, combine with `parent_project_id` when the name is used under several parents",
+			},
+			"path": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Project path, the names of the ancestor projects and the project joined by `/`, e.g. `Finance/Reporting/Monthly`",
+			},
+			"parent_project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the parent project, null for top level projects",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Project description",
+			},
+			"content_permissions": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether content permissions are locked to the project or managed by the content owners",
+			},
+			"owner_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user owning the project",
+			},
+		},
+	}
+}
+
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every project is needed to resolve the paths
+	projects, err := d.client.ListProjects(ctx, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Projects",
+			err.Error(),
+		)
+		return
+	}
+	paths := client.ProjectPaths(projects)
+
+	var matches []client.Project
+	for _, project := range projects {
+		switch {
+		case !state.ID.IsNull():
+			if project.ID != state.ID.ValueString() {
+				continue
+			}
+		case !state.Path.IsNull():
+			if paths[project.ID] != state.Path.ValueString() {
+				continue
+			}
+		default:
+			if project.Name != state.Name.ValueString() {
+				continue
+			}
+			if !state.ParentProjectID.IsNull() && project.ParentProjectID != state.ParentProjectID.ValueString() {
+				continue
+			}
+		}
+		matches = append(matches, project)
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Find Tableau Project",
+			"No Tableau project matches the given id, name or path.",
+		)
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Tableau Projects Found",
+			fmt.Sprintf("%d Tableau projects are named '%s', use path or parent_project_id to select one of them.", len(matches), state.Name.ValueString()),
+		)
+		return
+	}
+
+	state = newProjectDataSourceModel(matches[0], paths[matches[0].ID])
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	// Test cases for project data source
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_project" "uat_terraform_provider_test_child" {
+	name              = "uat-terraform-provider-test-child"
+	parent_project_id = tableau_project.uat_terraform_provider_test.id
+}
+
+data "tableau_project" "uat_terraform_provider_test_child" {
+	path = "${tableau_project.uat_terraform_provider_test.name}/${tableau_project.uat_terraform_provider_test_child.name}"
+}
+
+data "tableau_projects" "uat_terraform_provider_test" {
+	filter = "parentProjectId:eq:${tableau_project.uat_terraform_provider_test_child.parent_project_id}"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.tableau_project.uat_terraform_provider_test_child", "id", "tableau_project.uat_terraform_provider_test_child", "id"),
+					resource.TestCheckResourceAttr("data.tableau_project.uat_terraform_provider_test_child", "name", "uat-terraform-provider-test-child"),
+					resource.TestCheckResourceAttr("data.tableau_projects.uat_terraform_provider_test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.tableau_projects.uat_terraform_provider_test", "projects.0.path", "uat-terraform-provider-test/uat-terraform-provider-test-child"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &projectPermissionsResource{}
+	_ resource.ResourceWithConfigure   = &projectPermissionsResource{}
+	_ resource.ResourceWithImportState = &projectPermissionsResource{}
+)
+
+type projectPermissionsResource struct {
+	client *client.TableauClient
+}
+
+type projectPermissionsResourceModel struct {
+	ProjectID                    types.String `tfsdk:"project_id"`
+	Permissions                  types.Set    `tfsdk:"permissions"`
+	DefaultWorkbookPermissions   types.Set    `tfsdk:"default_workbook_permissions"`
+	DefaultDatasourcePermissions types.Set    `tfsdk:"default_datasource_permissions"`
+	DefaultFlowPermissions       types.Set    `tfsdk:"default_flow_permissions"`
+	DefaultMetricPermissions     types.Set    `tfsdk:"default_metric_permissions"`
+}
+
+// defaultPermissions maps the content types of the default permissions to
+// the attributes managing them.
+func (m *projectPermissionsResourceModel) defaultPermissions() map[string]*types.Set {
+	return map[string]*types.Set{
+		client.DefaultPermissionsWorkbooks:   &m.DefaultWorkbookPermissions,
+		client.DefaultPermissionsDatasources: &m.DefaultDatasourcePermissions,
+		client.DefaultPermissionsFlows:       &m.DefaultFlowPermissions,
+		client.DefaultPermissionsMetrics:     &m.DefaultMetricPermissions,
+	}
+}
+
+func NewProjectPermissionsResource() resource.Resource {
+	return &projectPermissionsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *projectPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_permissions"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the permissions of a project. Capabilities granted outside of Terraform are removed. " +
+			"Default permissions are only managed for the content types that are configured, and are cleared when their configuration is removed.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Project id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions":                    granteeCapabilitiesAttribute("Capabilities of users and groups on the project", true),
+			"default_workbook_permissions":   granteeCapabilitiesAttribute("Capabilities of users and groups on workbooks published to the project", false),
+			"default_datasource_permissions": granteeCapabilitiesAttribute("Capabilities of users and groups on data sources published to the project", false),
+			"default_flow_permissions":       granteeCapabilitiesAttribute("Capabilities of users and groups on flows published to the project", false),
+			"default_metric_permissions":     granteeCapabilitiesAttribute("Capabilities of users and groups on metrics published to the project", false),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *projectPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectPermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the permissions of the project
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *projectPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	permissions, err := r.client.GetProjectPermissions(ctx, state.ProjectID.ValueString())
+	if client.IsNotFound(err) {
+		// Project was deleted outside of Terraform, so the permissions must be recreated
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project Permissions",
+			"Could not read permissions of Tableau project "+state.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(r.refresh(ctx, &state, permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan projectPermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state projectPermissionsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Default permissions that are no longer managed are cleared, like the
+	// capabilities removed from a managed content type
+	planDefaultPermissions := plan.defaultPermissions()
+	for contentType, defaultPermissions := range state.defaultPermissions() {
+		if defaultPermissions.IsNull() || !planDefaultPermissions[contentType].IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.Append(r.deleteDefaultPermissions(ctx, state.ProjectID.ValueString(), contentType, *defaultPermissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Add and delete capabilities to match the plan
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state projectPermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+
+	// Delete managed project capabilities
+	permissions, diags := expandPermissions(ctx, state.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, permission := range permissions {
+		err := r.client.DeleteProjectPermission(ctx, projectID, permission)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau Project Permission",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Delete managed default capabilities
+	for contentType, defaultPermissions := range state.defaultPermissions() {
+		if defaultPermissions.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.Append(r.deleteDefaultPermissions(ctx, projectID, contentType, *defaultPermissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectPermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to project_id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+// apply adds and deletes capabilities so that the project matches the model,
+// then refreshes the model from the server.
+func (r *projectPermissionsResource) apply(ctx context.Context, model *projectPermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	projectID := model.ProjectID.ValueString()
+
+	// Project capabilities
+	desired, expandDiags := expandPermissions(ctx, model.Permissions)
+	diags.Append(expandDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	current, err := r.client.GetProjectPermissions(ctx, projectID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau Project Permissions",
+			"Could not read permissions of Tableau project "+projectID+": "+err.Error(),
+		)
+		return diags
+	}
+	err = applyPermissions(
+		current,
+		desired,
+		func(permissions []client.Permission) error {
+			return r.client.AddProjectPermissions(ctx, projectID, permissions)
+		},
+		func(permission client.Permission) error {
+			return r.client.DeleteProjectPermission(ctx, projectID, permission)
+		},
+	)
+	if err != nil {
+		diags.AddError(
+			"Unable to Update Tableau Project Permissions",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// Default capabilities, only for the content types that are managed
+	for contentType, defaultPermissions := range model.defaultPermissions() {
+		if defaultPermissions.IsNull() {
+			continue
+		}
+
+		contentType := contentType
+		desired, expandDiags := expandPermissions(ctx, *defaultPermissions)
+		diags.Append(expandDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		current, err := r.client.GetProjectDefaultPermissions(ctx, projectID, contentType)
+		if err != nil {
+			diags.AddError(
+				"Error Reading Tableau Project Default Permissions",
+				"Could not read default "+contentType+" permissions of Tableau project "+projectID+": "+err.Error(),
+			)
+			return diags
+		}
+		err = applyPermissions(
+			current,
+			desired,
+			func(permissions []client.Permission) error {
+				return r.client.AddProjectDefaultPermissions(ctx, projectID, contentType, permissions)
+			},
+			func(permission client.Permission) error {
+				return r.client.DeleteProjectDefaultPermission(ctx, projectID, contentType, permission)
+			},
+		)
+		if err != nil {
+			diags.AddError(
+				"Unable to Update Tableau Project Default Permissions",
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	// Get updated values
+	permissions, err := r.client.GetProjectPermissions(ctx, projectID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Tableau Project Permissions",
+			"Could not read permissions of Tableau project "+projectID+": "+err.Error(),
+		)
+		return diags
+	}
+
+	diags.Append(r.refresh(ctx, model, permissions)...)
+	return diags
+}
+
+// deleteDefaultPermissions deletes the default capabilities in set for
+// contentType, ignoring the ones already deleted.
+func (r *projectPermissionsResource) deleteDefaultPermissions(ctx context.Context, projectID string, contentType string, set types.Set) diag.Diagnostics {
+	permissions, diags := expandPermissions(ctx, set)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, permission := range permissions {
+		err := r.client.DeleteProjectDefaultPermission(ctx, projectID, contentType, permission)
+		if err != nil && !client.IsNotFound(err) {
+			diags.AddError(
+				"Unable to Delete Tableau Project Default Permission",
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// refresh overwrites the model with the given project permissions and the
+// managed default permissions, leaving unmanaged default permissions null.
+func (r *projectPermissionsResource) refresh(ctx context.Context, model *projectPermissionsResourceModel, permissions []client.Permission) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var flattenDiags diag.Diagnostics
+	projectID := model.ProjectID.ValueString()
+
+	model.Permissions, flattenDiags = flattenPermissions(ctx, permissions)
+	diags.Append(flattenDiags...)
+
+	for contentType, defaultPermissions := range model.defaultPermissions() {
+		if defaultPermissions.IsNull() {
+			continue
+		}
+
+		permissions, err := r.client.GetProjectDefaultPermissions(ctx, projectID, contentType)
+		if err != nil {
+			diags.AddError(
+				"Error Reading Tableau Project Default Permissions",
+				"Could not read default "+contentType+" permissions of Tableau project "+projectID+": "+err.Error(),
+			)
+			return diags
+		}
+		*defaultPermissions, flattenDiags = flattenPermissions(ctx, permissions)
+		diags.Append(flattenDiags...)
+	}
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectPermissionsResource(t *testing.T) {
+	// Test cases for project permissions resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_project_permissions" "uat_terraform_provider_test" {
+	project_id = tableau_project.uat_terraform_provider_test.id
+	permissions = [
+		{
+			group_id     = tableau_group.uat_terraform_provider_test.id
+			capabilities = {
+				Read  = "Allow"
+				Write = "Allow"
+			}
+		},
+	]
+	default_workbook_permissions = [
+		{
+			group_id     = tableau_group.uat_terraform_provider_test.id
+			capabilities = {
+				Read = "Allow"
+			}
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Read", "Allow"),
+					resource.TestCheckResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Write", "Allow"),
+					resource.TestCheckResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "default_workbook_permissions.0.capabilities.Read", "Allow"),
+					resource.TestCheckNoResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "default_flow_permissions"),
+				),
+			},
+			// ImportState testing, default permissions are only managed once configured
+			{
+				ResourceName:                         "tableau_project_permissions.uat_terraform_provider_test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+				ImportStateIdFunc:                    testAccImportStateAttribute("tableau_project_permissions.uat_terraform_provider_test", "project_id"),
+				ImportStateVerifyIgnore:              []string{"default_workbook_permissions"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test"
+}
+
+resource "tableau_project_permissions" "uat_terraform_provider_test" {
+	project_id = tableau_project.uat_terraform_provider_test.id
+	permissions = [
+		{
+			group_id     = tableau_group.uat_terraform_provider_test.id
+			capabilities = {
+				Read  = "Allow"
+				Write = "Deny"
+			}
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Write", "Deny"),
+					resource.TestCheckNoResourceAttr("tableau_project_permissions.uat_terraform_provider_test", "default_workbook_permissions.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
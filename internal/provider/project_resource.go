@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &projectResource{}
+	_ resource.ResourceWithConfigure   = &projectResource{}
+	_ resource.ResourceWithImportState = &projectResource{}
+)
+
+type projectResource struct {
+	client *client.TableauClient
+}
+
+type projectResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	ParentProjectID    types.String `tfsdk:"parent_project_id"`
+	ContentPermissions types.String `tfsdk:"content_permissions"`
+	OwnerID            types.String `tfsdk:"owner_id"`
+}
+
+func NewProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Project ID",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Project name, unique under the parent project",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Project description",
+			},
+			"parent_project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the parent project, the project is created at the top level when omitted",
+			},
+			"content_permissions": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Whether content permissions are locked to the project or managed by the content owners",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"LockedToProject",
+						"ManagedByOwner",
+						"LockedToProjectWithoutNested",
+					}...),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the user owning the project, defaults to the user the provider is authenticated as",
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create project
+	project, err := r.client.CreateProject(
+		ctx,
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		plan.ParentProjectID.ValueString(),
+		plan.ContentPermissions.ValueString(),
+		plan.OwnerID.ValueString(),
+	)
+	if project == nil && client.IsConflict(err) {
+		resp.Diagnostics.AddError(
+			"Tableau Project Already Exists",
+			fmt.Sprintf("A project named %q already exists in the parent project. Import it with terraform import to manage it with Terraform: %s", plan.Name.ValueString(), err),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Tableau Project",
+			err.Error(),
+		)
+		if project != nil {
+			// The project was created, track it so that it is replaced on the
+			// next apply instead of conflicting with the new one
+			setProjectResourceModel(&plan, project)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+		return
+	}
+
+	// Set ID and computed values
+	setProjectResourceModel(&plan, project)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state projectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	project, err := r.client.GetProject(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// Project was deleted outside of Terraform, so it must be recreated
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Project",
+			"Could not read Tableau project ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	setProjectResourceModel(&state, project)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan projectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update project
+	updatedProject, err := r.client.UpdateProject(
+		ctx,
+		plan.ID.ValueString(),
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		plan.ParentProjectID.ValueString(),
+		plan.ContentPermissions.ValueString(),
+		plan.OwnerID.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Tableau Project",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	setProjectResourceModel(&plan, updatedProject)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state projectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete project
+	err := r.client.DeleteProject(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// If the project is already deleted, we can ignore the error
+			resp.Diagnostics.AddWarning(
+				"Unable to Delete Tableau Project",
+				err.Error(),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau Project",
+				err.Error(),
+			)
+		}
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func setProjectResourceModel(model *projectResourceModel, project *client.Project) {
+	model.ID = types.StringValue(project.ID)
+	model.Name = types.StringValue(project.Name)
+	model.Description = types.StringValue(project.Description)
+	model.ContentPermissions = types.StringValue(project.ContentPermissions)
+	model.OwnerID = types.StringValue(project.OwnerID())
+
+	// Top level projects have no parent
+	model.ParentProjectID = types.StringNull()
+	if project.ParentProjectID != "" {
+		model.ParentProjectID = types.StringValue(project.ParentProjectID)
+	}
+}
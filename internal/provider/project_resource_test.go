@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	// Test cases for project resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "uat_terraform_provider_test" {
+	name        = "uat-terraform-provider-test"
+	description = "UAT - terraform provider test"
+}
+
+resource "tableau_project" "uat_terraform_provider_test_child" {
+	name              = "uat-terraform-provider-test-child"
+	parent_project_id = tableau_project.uat_terraform_provider_test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_project.uat_terraform_provider_test", "name", "uat-terraform-provider-test"),
+					resource.TestCheckResourceAttr("tableau_project.uat_terraform_provider_test", "description", "UAT - terraform provider test"),
+					resource.TestCheckResourceAttrPair("tableau_project.uat_terraform_provider_test_child", "parent_project_id", "tableau_project.uat_terraform_provider_test", "id"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_project.uat_terraform_provider_test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_project.uat_terraform_provider_test", "content_permissions"),
+					resource.TestCheckResourceAttrSet("tableau_project.uat_terraform_provider_test", "owner_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "tableau_project.uat_terraform_provider_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_project" "uat_terraform_provider_test" {
+	name                = "uat-terraform-provider-test-updated"
+	content_permissions = "LockedToProject"
+}
+
+resource "tableau_project" "uat_terraform_provider_test_child" {
+	name = "uat-terraform-provider-test-child"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_project.uat_terraform_provider_test", "name", "uat-terraform-provider-test-updated"),
+					resource.TestCheckResourceAttr("tableau_project.uat_terraform_provider_test", "description", ""),
+					resource.TestCheckResourceAttr("tableau_project.uat_terraform_provider_test", "content_permissions", "LockedToProject"),
+					resource.TestCheckNoResourceAttr("tableau_project.uat_terraform_provider_test_child", "parent_project_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
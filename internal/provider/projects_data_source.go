@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &projectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectsDataSource{}
+)
+
+type projectsDataSource struct {
+	client *client.TableauClient
+}
+
+type projectsDataSourceModel struct {
+	Filter   types.String             `tfsdk:"filter"`
+	Projects []projectDataSourceModel `tfsdk:"projects"`
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+func (d *projectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the list of projects",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Tableau filter expression, e.g. `name:eq:Finance` or `parentProjectId:eq:<id>`. All projects are returned when omitted",
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Projects matching the filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the project",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Project name",
+						},
+						"path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Project path, the names of the ancestor projects and the project joined by `/`",
+						},
+						"parent_project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the parent project, null for top level projects",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Project description",
+						},
+						"content_permissions": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether content permissions are locked to the project or managed by the content owners",
+						},
+						"owner_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user owning the project",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.ListProjects(ctx, state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Projects",
+			err.Error(),
+		)
+		return
+	}
+
+	// Resolving the paths of filtered projects needs their ancestors as well
+	allProjects := projects
+	if state.Filter.ValueString() != "" {
+		allProjects, err = d.client.ListProjects(ctx, "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Tableau Projects",
+				err.Error(),
+			)
+			return
+		}
+	}
+	paths := client.ProjectPaths(allProjects)
+
+	state.Projects = []projectDataSourceModel{}
+	for _, project := range projects {
+		state.Projects = append(state.Projects, newProjectDataSourceModel(project, paths[project.ID]))
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
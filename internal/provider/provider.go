@@ -4,12 +4,15 @@ import (
 	"context"
 	"os"
 	"terraform-provider-tableau/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -33,6 +36,12 @@ type tableauCloudProviderModel struct {
 	PersonalAccessTokenName   types.String `tfsdk:"personal_access_token_name"`
 	PersonalAccessTokenSecret types.String `tfsdk:"personal_access_token_secret"`
 	Site                      types.String `tfsdk:"site"`
+	MaxRetryAttempts          types.Int64  `tfsdk:"max_retry_attempts"`
+	RetryMinBackoff           types.Int64  `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff           types.Int64  `tfsdk:"retry_max_backoff"`
+	RetryableStatusCodes      types.Set    `tfsdk:"retryable_status_codes"`
+	MaxConcurrency            types.Int64  `tfsdk:"max_concurrency"`
+	UploadTimeout             types.Int64  `tfsdk:"upload_timeout"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -78,6 +87,47 @@ func (p *tableauProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"max_retry_attempts": schema.Int64Attribute{
+				Description: "Maximum number of attempts for a request to Tableau, including the first one. Defaults to `3`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_min_backoff": schema.Int64Attribute{
+				Description: "Delay in seconds before the first retry, doubled on every subsequent retry with random jitter. Defaults to `1`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_backoff": schema.Int64Attribute{
+				Description: "Maximum delay in seconds between retries. A `Retry-After` header sent by Tableau takes precedence. Defaults to `30`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retryable_status_codes": schema.SetAttribute{
+				Description: "HTTP status codes that are retried. Requests creating objects are only retried on `429`. Defaults to `[429, 500, 502, 503, 504]`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Description: "Maximum number of requests sent at once when a resource changes many objects, such as the members of a group. Defaults to `4`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"upload_timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds of the requests uploading and publishing workbook and data source files, " +
+					"including the time Tableau takes to process them. Other requests time out after 10 seconds. Defaults to `1800`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -216,10 +266,45 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	// Override the default retry policy with the configured values
+	retryPolicy := client.DefaultRetryPolicy()
+	if !config.MaxRetryAttempts.IsNull() && !config.MaxRetryAttempts.IsUnknown() {
+		retryPolicy.MaxAttempts = uint(config.MaxRetryAttempts.ValueInt64())
+	}
+	if !config.RetryMinBackoff.IsNull() && !config.RetryMinBackoff.IsUnknown() {
+		retryPolicy.MinBackoff = time.Duration(config.RetryMinBackoff.ValueInt64()) * time.Second
+	}
+	if !config.RetryMaxBackoff.IsNull() && !config.RetryMaxBackoff.IsUnknown() {
+		retryPolicy.MaxBackoff = time.Duration(config.RetryMaxBackoff.ValueInt64()) * time.Second
+	}
+	if !config.RetryableStatusCodes.IsNull() && !config.RetryableStatusCodes.IsUnknown() {
+		var retryableStatusCodes []int64
+		diags = config.RetryableStatusCodes.ElementsAs(ctx, &retryableStatusCodes, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		retryPolicy.RetryableStatusCodes = nil
+		for _, statusCode := range retryableStatusCodes {
+			retryPolicy.RetryableStatusCodes = append(retryPolicy.RetryableStatusCodes, int(statusCode))
+		}
+	}
+
+	maxConcurrency := client.DefaultMaxConcurrency
+	if !config.MaxConcurrency.IsNull() && !config.MaxConcurrency.IsUnknown() {
+		maxConcurrency = int(config.MaxConcurrency.ValueInt64())
+	}
+
+	uploadTimeout := client.DefaultUploadTimeout
+	if !config.UploadTimeout.IsNull() && !config.UploadTimeout.IsUnknown() {
+		uploadTimeout = time.Duration(config.UploadTimeout.ValueInt64()) * time.Second
+	}
+
 	tflog.Debug(ctx, "Creating Tableau client")
 
 	// Create a new Tableau client using the configuration values
-	client, err := client.NewTableauClient(serverURL, apiVersion, site, personalAccessTokenName, personalAccessTokenSecret)
+	client, err := client.NewTableauClient(ctx, serverURL, apiVersion, site, personalAccessTokenName, personalAccessTokenSecret, retryPolicy, maxConcurrency, uploadTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Tableau API Client",
@@ -242,7 +327,11 @@ func (p *tableauProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *tableauProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
 	}
 }
 
@@ -252,5 +341,15 @@ func (p *tableauProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewUserResource,
 		NewGroupResource,
 		NewGroupMembershipResource,
+		NewGroupMemberResource,
+		NewProjectResource,
+		NewProjectPermissionsResource,
+		NewWorkbookPermissionsResource,
+		NewDatasourcePermissionsResource,
+		NewViewPermissionsResource,
+		NewWorkbookResource,
+		NewDatasourceResource,
+		NewDatasourceConnectionResource,
+		NewWorkbookConnectionResource,
 	}
 }
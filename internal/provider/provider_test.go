@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const providerConfig = `
@@ -18,3 +22,16 @@ var (
 		"tableau": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccImportStateAttribute imports resources without an id attribute by
+// the value of attribute of resourceName.
+func testAccImportStateAttribute(resourceName string, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		return rs.Primary.Attributes[attribute], nil
+	}
+}
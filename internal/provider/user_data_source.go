@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"terraform-provider-tableau/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,9 +24,20 @@ type userDataSource struct {
 
 type userDataSourceModel struct {
 	ID          types.String `tfsdk:"id"`
-	Email       types.String `tfsdk:"email"`
+	Name        types.String `tfsdk:"name"`
+	FullName    types.String `tfsdk:"full_name"`
+	Email       emailValue   `tfsdk:"email"`
 	SiteRole    types.String `tfsdk:"site_role"`
 	AuthSetting types.String `tfsdk:"auth_setting"`
+
+	LastLogin            types.String `tfsdk:"last_login"`
+	Locale               types.String `tfsdk:"locale"`
+	Language             types.String `tfsdk:"language"`
+	ExternalAuthUserID   types.String `tfsdk:"external_auth_user_id"`
+	IdpConfigurationID   types.String `tfsdk:"idp_configuration_id"`
+	DomainName           types.String `tfsdk:"domain_name"`
+	OwnedWorkbookCount   types.Int64  `tfsdk:"owned_workbook_count"`
+	OwnedDatasourceCount types.Int64  `tfsdk:"owned_datasource_count"`
 }
 
 func NewUserDataSource() datasource.DataSource {
@@ -36,15 +50,29 @@ func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 
 func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieve user details",
+		Description: "Retrieve user details by username or email",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the user",
 			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Username. Exactly one of `name` or `email` must be set",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Display name of the user",
+			},
 			"email": schema.StringAttribute{
-				Required:    true,
-				Description: "User email",
+				Optional:    true,
+				Computed:    true,
+				CustomType:  emailType{},
+				Description: "User email. Exactly one of `name` or `email` must be set",
 			},
 			"site_role": schema.StringAttribute{
 				Computed:    true,
@@ -54,6 +82,38 @@ func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:    true,
 				Description: "Auth setting for the user",
 			},
+			"last_login": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the last sign in of the user, in RFC 3339 format. Null if the user never signed in",
+			},
+			"locale": schema.StringAttribute{
+				Computed:    true,
+				Description: "Locale of the user",
+			},
+			"language": schema.StringAttribute{
+				Computed:    true,
+				Description: "Language of the user",
+			},
+			"external_auth_user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user in the external identity provider",
+			},
+			"idp_configuration_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the identity provider configuration used by the user",
+			},
+			"domain_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the domain of the user, `local` for users managed by Tableau",
+			},
+			"owned_workbook_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of workbooks owned by the user, counted with an extra request on every refresh",
+			},
+			"owned_datasource_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of data sources owned by the user, counted with an extra request on every refresh",
+			},
 		},
 	}
 }
@@ -63,7 +123,32 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	user, err := d.client.GetUserByEmail(state.Email.ValueString())
+	var user *client.User
+	var err error
+	if !state.Name.IsNull() {
+		user, err = d.client.GetUserByName(ctx, state.Name.ValueString())
+	} else {
+		user, err = d.client.GetUserByEmail(ctx, state.Email.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau User",
+			err.Error(),
+		)
+		return
+	}
+
+	// User lookups by name or email only return some details
+	user, err = d.client.GetUser(ctx, user.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau User",
+			err.Error(),
+		)
+		return
+	}
+
+	counts, err := d.client.GetUserOwnedContentCounts(ctx, user)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau User",
@@ -72,10 +157,20 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	state.ID = types.StringValue("placeholder")
-	state.Email = types.StringValue(user.Email)
+	state.ID = types.StringValue(user.ID)
+	state.Name = types.StringValue(user.Name)
+	state.FullName = types.StringValue(user.FullName)
+	state.Email = newEmailValue(user.Email)
 	state.SiteRole = types.StringValue(user.SiteRole)
 	state.AuthSetting = types.StringValue(user.AuthSetting)
+	state.LastLogin = lastLoginValue(user)
+	state.Locale = types.StringValue(user.Locale)
+	state.Language = types.StringValue(user.Language)
+	state.ExternalAuthUserID = types.StringValue(user.ExternalAuthUserID)
+	state.IdpConfigurationID = types.StringValue(user.IdpConfigurationID)
+	state.DomainName = types.StringValue(user.DomainName())
+	state.OwnedWorkbookCount = types.Int64Value(int64(counts.Workbooks))
+	state.OwnedDatasourceCount = types.Int64Value(int64(counts.Datasources))
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -27,6 +27,58 @@ data "tableau_user" "uat_test" {
 					resource.TestCheckResourceAttr("data.tableau_user.uat_test", "email", "uat_test@example.com"),
 					resource.TestCheckResourceAttr("data.tableau_user.uat_test", "site_role", "Unlicensed"),
 					resource.TestCheckResourceAttr("data.tableau_user.uat_test", "auth_setting", "OpenID"),
+					resource.TestCheckResourceAttr("data.tableau_user.uat_test", "owned_workbook_count", "0"),
+					resource.TestCheckResourceAttr("data.tableau_user.uat_test", "owned_datasource_count", "0"),
+					resource.TestCheckNoResourceAttr("data.tableau_user.uat_test", "last_login"),
+					resource.TestCheckResourceAttrPair("data.tableau_user.uat_test", "id", "tableau_user.uat_test", "id"),
+				),
+			},
+			// Read by username testing
+			{
+				Config: providerConfig + `
+resource "tableau_user" "uat_test" {
+	email 		 = "uat_test@example.com"
+	site_role 	 = "Unlicensed"
+	auth_setting = "OpenID"
+}
+
+data "tableau_user" "uat_test" {
+	name = resource.tableau_user.uat_test.name
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tableau_user.uat_test", "email", "uat_test@example.com"),
+					resource.TestCheckResourceAttrPair("data.tableau_user.uat_test", "id", "tableau_user.uat_test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUsersDataSource(t *testing.T) {
+	// Test cases for users data source
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_user" "uat_test" {
+	email 		 = "uat_test@example.com"
+	site_role 	 = "Unlicensed"
+	auth_setting = "OpenID"
+}
+
+data "tableau_users" "uat_test" {
+	filter = "siteRole:eq:Unlicensed"
+	sort   = "name:asc"
+	emails = [
+		upper(resource.tableau_user.uat_test.email),
+	]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tableau_users.uat_test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.tableau_users.uat_test", "users.0.id", "tableau_user.uat_test", "id"),
+					resource.TestCheckResourceAttr("data.tableau_users.uat_test", "users.0.site_role", "Unlicensed"),
 				),
 			},
 		},
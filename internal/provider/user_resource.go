@@ -7,12 +7,14 @@ import (
 	"terraform-provider-tableau/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,9 +30,22 @@ type userResource struct {
 
 type userResourceModel struct {
 	ID          types.String `tfsdk:"id"`
-	Email       types.String `tfsdk:"email"`
+	Name        types.String `tfsdk:"name"`
+	FullName    types.String `tfsdk:"full_name"`
+	Email       emailValue   `tfsdk:"email"`
 	SiteRole    types.String `tfsdk:"site_role"`
 	AuthSetting types.String `tfsdk:"auth_setting"`
+
+	TransferContentToUserID types.String `tfsdk:"transfer_content_to_user_id"`
+
+	LastLogin            types.String `tfsdk:"last_login"`
+	Locale               types.String `tfsdk:"locale"`
+	Language             types.String `tfsdk:"language"`
+	ExternalAuthUserID   types.String `tfsdk:"external_auth_user_id"`
+	IdpConfigurationID   types.String `tfsdk:"idp_configuration_id"`
+	DomainName           types.String `tfsdk:"domain_name"`
+	OwnedWorkbookCount   types.Int64  `tfsdk:"owned_workbook_count"`
+	OwnedDatasourceCount types.Int64  `tfsdk:"owned_datasource_count"`
 }
 
 func NewUserResource() resource.Resource {
@@ -53,8 +68,26 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 				Description: "User ID",
 			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: "Username, which can't be changed once the user is created. Defaults to the email",
+			},
+			"full_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Display name of the user",
+			},
 			"email": schema.StringAttribute{
 				Required:    true,
+				CustomType:  emailType{},
 				Description: "User email",
 			},
 			"site_role": schema.StringAttribute{
@@ -87,6 +120,44 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					}...),
 				},
 			},
+			"transfer_content_to_user_id": schema.StringAttribute{
+				Optional: true,
+				Description: "ID of the user that becomes the owner of the content of the user when it is deleted. " +
+					"Without it, deleting a user that owns workbooks or data sources fails. Tableau keeps a user owning other content, such as flows, " +
+					"as `Unlicensed` instead of removing it, which also fails the deletion. Like any other attribute, it must be applied before the user is deleted",
+			},
+			"last_login": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time of the last sign in of the user, in RFC 3339 format. Null if the user never signed in",
+			},
+			"locale": schema.StringAttribute{
+				Computed:    true,
+				Description: "Locale of the user",
+			},
+			"language": schema.StringAttribute{
+				Computed:    true,
+				Description: "Language of the user",
+			},
+			"external_auth_user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user in the external identity provider",
+			},
+			"idp_configuration_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the identity provider configuration used by the user",
+			},
+			"domain_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the domain of the user, `local` for users managed by Tableau",
+			},
+			"owned_workbook_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of workbooks owned by the user, counted with an extra request on every refresh",
+			},
+			"owned_datasource_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of data sources owned by the user, counted with an extra request on every refresh",
+			},
 		},
 	}
 }
@@ -101,22 +172,55 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Usernames default to the email
+	name := plan.Email.ValueString()
+	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
+		name = plan.Name.ValueString()
+	}
+
 	// Create user
 	user, err := r.client.CreateUser(
+		ctx,
+		name,
 		plan.Email.ValueString(),
+		plan.FullName.ValueString(),
 		plan.SiteRole.ValueString(),
 		plan.AuthSetting.ValueString(),
 	)
+	if user == nil && client.IsConflict(err) {
+		resp.Diagnostics.AddError(
+			"Tableau User Already Exists",
+			fmt.Sprintf("A user named %q already exists on the site. Import it with terraform import to manage it with Terraform: %s", name, err),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Tableau User",
 			err.Error(),
 		)
+		if user != nil {
+			// The user was added, track it so that it is replaced on the next
+			// apply instead of conflicting with the new one
+			resp.Diagnostics.Append(setCreatedUserState(ctx, &resp.State, plan, user)...)
+		}
 		return
 	}
 
-	// Set ID
-	plan.ID = types.StringValue(user.ID)
+	// Fetch created user from server, as creation only returns some details
+	createdUser := user
+	user, err = r.client.GetUser(ctx, createdUser.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau User",
+			err.Error(),
+		)
+		resp.Diagnostics.Append(setCreatedUserState(ctx, &resp.State, plan, createdUser)...)
+		return
+	}
+
+	// Set ID and computed values, new users don't own any content
+	setUserResourceModel(&plan, user, &client.OwnedContentCounts{})
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -126,6 +230,14 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 }
 
+// setCreatedUserState records a user that was added to the site although its
+// creation failed afterwards, so that Terraform taints it rather than losing
+// track of it.
+func setCreatedUserState(ctx context.Context, state *tfsdk.State, plan userResourceModel, user *client.User) diag.Diagnostics {
+	setUserResourceModel(&plan, user, &client.OwnedContentCounts{})
+	return state.Set(ctx, plan)
+}
+
 // Read resource information.
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
@@ -140,9 +252,29 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	var err error
 
 	userID := state.ID.ValueString()
-	if strings.HasPrefix(userID, "email/") {
+	if strings.HasPrefix(userID, "name/") {
+		name := strings.TrimPrefix(userID, "name/")
+		user, err = r.client.GetUserByName(ctx, name)
+		if client.IsNotFound(err) {
+			// User was deleted outside of Terraform, so it must be recreated
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Tableau User",
+				"Could not read Tableau user name "+name+": "+err.Error(),
+			)
+			return
+		}
+	} else if strings.HasPrefix(userID, "email/") {
 		email := strings.Split(userID, "/")[1]
-		user, err = r.client.GetUserByEmail(email)
+		user, err = r.client.GetUserByEmail(ctx, email)
+		if client.IsNotFound(err) {
+			// User was deleted outside of Terraform, so it must be recreated
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Tableau User",
@@ -152,7 +284,12 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	} else {
 		// Get refreshed values
-		user, err = r.client.GetUser(userID)
+		user, err = r.client.GetUser(ctx, userID)
+		if client.IsNotFound(err) {
+			// User was deleted outside of Terraform, so it must be recreated
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Tableau User",
@@ -162,11 +299,30 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
+	// User lookups by name or email only return some details
+	if strings.HasPrefix(userID, "name/") || strings.HasPrefix(userID, "email/") {
+		userID = user.ID
+		user, err = r.client.GetUser(ctx, userID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Tableau User",
+				"Could not read Tableau user ID "+userID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	counts, err := r.client.GetUserOwnedContentCounts(ctx, user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau User",
+			"Could not count content owned by Tableau user ID "+user.ID+": "+err.Error(),
+		)
+		return
+	}
+
 	// Overwrite items with refreshed state
-	state.ID = types.StringValue(user.ID)
-	state.Email = types.StringValue(user.Email)
-	state.SiteRole = types.StringValue(user.SiteRole)
-	state.AuthSetting = types.StringValue(user.AuthSetting)
+	setUserResourceModel(&state, user, counts)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -188,8 +344,10 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Update user
 	_, err := r.client.UpdateUser(
+		ctx,
 		plan.ID.ValueString(),
 		plan.Email.ValueString(),
+		plan.FullName.ValueString(),
 		plan.SiteRole.ValueString(),
 		plan.AuthSetting.ValueString(),
 	)
@@ -202,7 +360,16 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Fetch updated user from server
-	updatedUser, err := r.client.GetUser(plan.ID.ValueString())
+	updatedUser, err := r.client.GetUser(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau User",
+			err.Error(),
+		)
+		return
+	}
+
+	counts, err := r.client.GetUserOwnedContentCounts(ctx, updatedUser)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tableau User",
@@ -212,10 +379,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Update resource state with updated values
-	plan.ID = types.StringValue(updatedUser.ID)
-	plan.Email = types.StringValue(updatedUser.Email)
-	plan.SiteRole = types.StringValue(updatedUser.SiteRole)
-	plan.AuthSetting = types.StringValue(updatedUser.AuthSetting)
+	setUserResourceModel(&plan, updatedUser, counts)
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
@@ -235,10 +399,44 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// Content would be left without owner, so refuse to delete its owner
+	// unless the content is transferred
+	if state.TransferContentToUserID.IsNull() {
+		user := &client.User{ID: state.ID.ValueString(), Name: state.Name.ValueString()}
+		var err error
+		if user.Name == "" {
+			// State written before the name was tracked, data sources are
+			// looked up by owner name
+			user, err = r.client.GetUser(ctx, state.ID.ValueString())
+		}
+		var ownedContent *client.OwnedContent
+		if err == nil {
+			ownedContent, err = r.client.ListUserOwnedContent(ctx, user)
+		}
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau User",
+				err.Error(),
+			)
+			return
+		}
+		if err == nil && !ownedContent.IsEmpty() {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau User",
+				fmt.Sprintf(
+					"User %s owns %s. Set transfer_content_to_user_id to transfer the content to another user before deleting this one.",
+					user.Name,
+					describeOwnedContent(ownedContent),
+				),
+			)
+			return
+		}
+	}
+
 	// Delete user
-	err := r.client.DeleteUser(state.ID.ValueString())
+	err := r.client.DeleteUser(ctx, state.ID.ValueString(), state.TransferContentToUserID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if client.IsNotFound(err) {
 			// User does not exist, so we can ignore this error
 			resp.Diagnostics.AddWarning(
 				"Unable to Delete Tableau User",
@@ -252,6 +450,29 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		}
 		return
 	}
+
+	// Users owning content that could not be transferred, such as flows
+	// without transfer_content_to_user_id, are only made Unlicensed
+	user, err := r.client.GetUser(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau User",
+			"Could not read Tableau user ID "+state.ID.ValueString()+" after deleting it: "+err.Error(),
+		)
+		return
+	}
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Tableau User",
+			fmt.Sprintf(
+				"User %s still owns content, such as flows or metrics, and was made %s instead of being removed. "+
+					"Set transfer_content_to_user_id to transfer the content to another user before deleting this one.",
+				user.Name,
+				user.SiteRole,
+			),
+		)
+		return
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -274,7 +495,55 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
+func setUserResourceModel(model *userResourceModel, user *client.User, counts *client.OwnedContentCounts) {
+	model.ID = types.StringValue(user.ID)
+	model.Name = types.StringValue(user.Name)
+	model.FullName = types.StringValue(user.FullName)
+	model.Email = newEmailValue(user.Email)
+	model.SiteRole = types.StringValue(user.SiteRole)
+	model.AuthSetting = types.StringValue(user.AuthSetting)
+	model.LastLogin = lastLoginValue(user)
+	model.Locale = types.StringValue(user.Locale)
+	model.Language = types.StringValue(user.Language)
+	model.ExternalAuthUserID = types.StringValue(user.ExternalAuthUserID)
+	model.IdpConfigurationID = types.StringValue(user.IdpConfigurationID)
+	model.DomainName = types.StringValue(user.DomainName())
+	model.OwnedWorkbookCount = types.Int64Value(int64(counts.Workbooks))
+	model.OwnedDatasourceCount = types.Int64Value(int64(counts.Datasources))
+}
+
+// lastLoginValue returns the last sign in of user, null if the user never
+// signed in.
+func lastLoginValue(user *client.User) types.String {
+	if user.LastLogin == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(user.LastLogin)
+}
+
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// describeOwnedContent lists the names of the workbooks and data sources owned
+// by a user.
+func describeOwnedContent(ownedContent *client.OwnedContent) string {
+	var descriptions []string
+	if len(ownedContent.Workbooks) > 0 {
+		names := make([]string, len(ownedContent.Workbooks))
+		for i, workbook := range ownedContent.Workbooks {
+			names[i] = workbook.Name
+		}
+		descriptions = append(descriptions, fmt.Sprintf("workbooks %s", strings.Join(names, ", ")))
+	}
+	if len(ownedContent.Datasources) > 0 {
+		names := make([]string, len(ownedContent.Datasources))
+		for i, datasource := range ownedContent.Datasources {
+			names[i] = datasource.Name
+		}
+		descriptions = append(descriptions, fmt.Sprintf("data sources %s", strings.Join(names, ", ")))
+	}
+
+	return strings.Join(descriptions, " and ")
+}
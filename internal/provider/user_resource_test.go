@@ -22,8 +22,12 @@ resource "tableau_user" "uat_test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("tableau_user.uat_test", "email", "uat_test@example.com"),
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "name", "uat_test@example.com"),
 					resource.TestCheckResourceAttr("tableau_user.uat_test", "site_role", "Unlicensed"),
 					resource.TestCheckResourceAttr("tableau_user.uat_test", "auth_setting", "OpenID"),
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "owned_workbook_count", "0"),
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "owned_datasource_count", "0"),
+					resource.TestCheckNoResourceAttr("tableau_user.uat_test", "last_login"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("tableau_user.uat_test", "id"),
 				),
@@ -38,18 +42,64 @@ resource "tableau_user" "uat_test" {
 			{
 				Config: providerConfig + `
 resource "tableau_user" "uat_test" {
+	full_name 	 = "UAT Test"
 	email 		 = "uat_test@example.com"
 	site_role 	 = "Viewer"
 	auth_setting = "SAML"
+
+	transfer_content_to_user_id = tableau_user.uat_test_new_owner.id
+}
+
+resource "tableau_user" "uat_test_new_owner" {
+	email 		 = "uat_test_new_owner@example.com"
+	site_role 	 = "Creator"
+	auth_setting = "SAML"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("tableau_user.uat_test", "email", "uat_test@example.com"),
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "full_name", "UAT Test"),
 					resource.TestCheckResourceAttr("tableau_user.uat_test", "site_role", "Viewer"),
 					resource.TestCheckResourceAttr("tableau_user.uat_test", "auth_setting", "SAML"),
+					resource.TestCheckResourceAttrPair("tableau_user.uat_test", "transfer_content_to_user_id", "tableau_user.uat_test_new_owner", "id"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestAccUserResourceWithName(t *testing.T) {
+	// Test cases for user resource with a username different from the email
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "tableau_user" "uat_test" {
+	name 		 = "uat_test"
+	full_name 	 = "UAT Test"
+	email 		 = "uat_test@example.com"
+	site_role 	 = "Unlicensed"
+	auth_setting = "SAML"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "name", "uat_test"),
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "full_name", "UAT Test"),
+					resource.TestCheckResourceAttr("tableau_user.uat_test", "email", "uat_test@example.com"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_user.uat_test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "tableau_user.uat_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+type usersDataSource struct {
+	client *client.TableauClient
+}
+
+type usersDataSourceModel struct {
+	Filter types.String               `tfsdk:"filter"`
+	Sort   types.String               `tfsdk:"sort"`
+	Emails emailSetValue              `tfsdk:"emails"`
+	Users  []usersDataSourceUserModel `tfsdk:"users"`
+}
+
+type usersDataSourceUserModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	FullName           types.String `tfsdk:"full_name"`
+	Email              types.String `tfsdk:"email"`
+	SiteRole           types.String `tfsdk:"site_role"`
+	AuthSetting        types.String `tfsdk:"auth_setting"`
+	LastLogin          types.String `tfsdk:"last_login"`
+	Locale             types.String `tfsdk:"locale"`
+	Language           types.String `tfsdk:"language"`
+	ExternalAuthUserID types.String `tfsdk:"external_auth_user_id"`
+	IdpConfigurationID types.String `tfsdk:"idp_configuration_id"`
+	DomainName         types.String `tfsdk:"domain_name"`
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the list of users",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional: true,
+				Description: "Tableau filter expression, e.g. `siteRole:eq:Creator`, `lastLogin:lt:2023-01-01T00:00:00Z` or `name:in:[jdoe,asmith]`. " +
+					"Several expressions are combined with `,`. All users are returned when omitted",
+			},
+			"sort": schema.StringAttribute{
+				Optional:    true,
+				Description: "Tableau sort expression, e.g. `name:asc` or `lastLogin:desc`. Several expressions are combined with `,`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\w+:(asc|desc)(,\w+:(asc|desc))*$`),
+						"must be a list of `field:asc` or `field:desc` separated by `,`",
+					),
+				},
+			},
+			"emails": schema.SetAttribute{
+				Optional:    true,
+				CustomType:  newEmailSetType(),
+				ElementType: types.StringType,
+				Description: "Only return the users with one of these emails, compared regardless of case. " +
+					"Tableau can't filter users on email, so they are matched after applying `filter`",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Username",
+						},
+						"full_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the user",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "User email",
+						},
+						"site_role": schema.StringAttribute{
+							Computed:    true,
+							Description: "Site role for the user",
+						},
+						"auth_setting": schema.StringAttribute{
+							Computed:    true,
+							Description: "Auth setting for the user",
+						},
+						"last_login": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time of the last sign in of the user, in RFC 3339 format. Null if the user never signed in",
+						},
+						"locale": schema.StringAttribute{
+							Computed:    true,
+							Description: "Locale of the user",
+						},
+						"language": schema.StringAttribute{
+							Computed:    true,
+							Description: "Language of the user",
+						},
+						"external_auth_user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user in the external identity provider",
+						},
+						"idp_configuration_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the identity provider configuration used by the user",
+						},
+						"domain_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the domain of the user, `local` for users managed by Tableau",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx, state.Filter.ValueString(), state.Sort.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tableau Users",
+			err.Error(),
+		)
+		return
+	}
+
+	var emails []string
+	if !state.Emails.IsNull() {
+		resp.Diagnostics.Append(state.Emails.ElementsAs(ctx, &emails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	foldedEmails := foldEmails(emails)
+
+	state.Users = []usersDataSourceUserModel{}
+	for _, user := range users {
+		if !state.Emails.IsNull() && !foldedEmails[strings.ToLower(user.Email)] {
+			continue
+		}
+
+		state.Users = append(state.Users, usersDataSourceUserModel{
+			ID:                 types.StringValue(user.ID),
+			Name:               types.StringValue(user.Name),
+			FullName:           types.StringValue(user.FullName),
+			Email:              types.StringValue(user.Email),
+			SiteRole:           types.StringValue(user.SiteRole),
+			AuthSetting:        types.StringValue(user.AuthSetting),
+			LastLogin:          lastLoginValue(&user),
+			Locale:             types.StringValue(user.Locale),
+			Language:           types.StringValue(user.Language),
+			ExternalAuthUserID: types.StringValue(user.ExternalAuthUserID),
+			IdpConfigurationID: types.StringValue(user.IdpConfigurationID),
+			DomainName:         types.StringValue(user.DomainName()),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccViewPermissionsResource(t *testing.T) {
+	// View IDs are generated when publishing a workbook, so the test runs
+	// against an existing view
+	viewID := os.Getenv("TABLEAU_TEST_VIEW_ID")
+	if viewID == "" {
+		t.Skip("TABLEAU_TEST_VIEW_ID must be set")
+	}
+
+	config := func(exportData string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "tableau_group" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-view-permissions"
+}
+
+resource "tableau_view_permissions" "uat_terraform_provider_test" {
+	view_id = %q
+	permissions = [
+		{
+			group_id     = tableau_group.uat_terraform_provider_test.id
+			capabilities = {
+				Read       = "Allow"
+				ExportData = %q
+			}
+		},
+	]
+}
+`, viewID, exportData)
+	}
+
+	// Test cases for view permissions resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("Allow"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_view_permissions.uat_terraform_provider_test", "view_id", viewID),
+					resource.TestCheckResourceAttr("tableau_view_permissions.uat_terraform_provider_test", "permissions.0.capabilities.Read", "Allow"),
+					resource.TestCheckResourceAttr("tableau_view_permissions.uat_terraform_provider_test", "permissions.0.capabilities.ExportData", "Allow"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "tableau_view_permissions.uat_terraform_provider_test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "view_id",
+				ImportStateId:                        viewID,
+			},
+			// Update and Read testing
+			{
+				Config: config("Deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_view_permissions.uat_terraform_provider_test", "permissions.0.capabilities.ExportData", "Deny"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tableau/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &workbookResource{}
+	_ resource.ResourceWithConfigure   = &workbookResource{}
+	_ resource.ResourceWithImportState = &workbookResource{}
+	_ resource.ResourceWithModifyPlan  = &workbookResource{}
+)
+
+type workbookResource struct {
+	client *client.TableauClient
+}
+
+type workbookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	FilePath    types.String `tfsdk:"file_path"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Description types.String `tfsdk:"description"`
+	ShowTabs    types.Bool   `tfsdk:"show_tabs"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Overwrite   types.Bool   `tfsdk:"overwrite"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+	WebpageUrl  types.String `tfsdk:"webpage_url"`
+}
+
+func NewWorkbookResource() resource.Resource {
+	return &workbookResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *workbookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workbook"
+}
+
+// Schema defines the schema for the resource.
+func (r *workbookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publish a workbook file to a project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Workbook ID",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Workbook name, unique in the project",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project the workbook is published to",
+			},
+			"file_path": schema.StringAttribute{
+				Required: true,
+				Description: "Path of the .twb or .twbx file to publish. " +
+					"Files larger than 8 MB are uploaded in chunks before being published",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed: true,
+				Description: "SHA-256 hash of the content of the file, the workbook is published again whenever it changes. " +
+					"Null when the workbook was imported, until the file is published again on the next apply",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Workbook description",
+			},
+			"show_tabs": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the sheets of the workbook are shown as tabs. Defaults to `false`",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Description: "Tags of the workbook, any other tag added on Tableau is removed",
+			},
+			"overwrite": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether creating the resource replaces a workbook with the same name in the project, rather than failing. " +
+					"Changes of the file always overwrite the workbook. Defaults to `false`",
+			},
+			"content_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the workbook in URLs",
+			},
+			"webpage_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the workbook on Tableau",
+			},
+		},
+	}
+}
+
+// ModifyPlan plans the hash of the content of the file, so that changes of the
+// file are planned as an update that publishes the workbook again.
+func (r *workbookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planFileContentHash(ctx, req, resp)
+}
+
+// Create a new resource.
+func (r *workbookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workbookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Publish and tag workbook
+	workbook, err := r.publisher().create(ctx, func(ctx context.Context) (*client.Workbook, error) {
+		return r.client.PublishWorkbook(ctx, plan.FilePath.ValueString(), r.expandWorkbook(plan), plan.Overwrite.ValueBool())
+	}, tags)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Tableau Workbook",
+			err.Error(),
+		)
+		if workbook != nil {
+			// Record the published workbook, so that it gets replaced rather
+			// than published again next to itself
+			setWorkbookResourceModel(&plan, workbook)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+		return
+	}
+
+	// Set ID and computed values
+	setWorkbookResourceModel(&plan, workbook)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *workbookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state workbookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed values
+	workbook, err := r.client.GetWorkbook(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		// Workbook was deleted outside of Terraform, so it must be published again
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tableau Workbook",
+			"Could not read Tableau workbook ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state, the file can't be read back
+	setWorkbookResourceModel(&state, workbook)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workbookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan workbookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state workbookResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rename and move the workbook first, so that publishing the file again
+	// overwrites it under its new name and project
+	if !plan.Name.Equal(state.Name) || !plan.ProjectID.Equal(state.ProjectID) ||
+		!plan.Description.Equal(state.Description) || !plan.ShowTabs.Equal(state.ShowTabs) {
+		_, err := r.client.UpdateWorkbook(
+			ctx,
+			plan.ID.ValueString(),
+			plan.Name.ValueString(),
+			plan.Description.ValueString(),
+			plan.ShowTabs.ValueBool(),
+			plan.ProjectID.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Tableau Workbook",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Publish the file again when its content changed
+	var publish func(ctx context.Context) (*client.Workbook, error)
+	if !plan.ContentHash.Equal(state.ContentHash) {
+		publish = func(ctx context.Context) (*client.Workbook, error) {
+			return r.client.PublishWorkbook(ctx, plan.FilePath.ValueString(), r.expandWorkbook(plan), true)
+		}
+	}
+
+	workbook, err := r.publisher().update(ctx, plan.ID.ValueString(), publish, tags)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Tableau Workbook",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated values
+	setWorkbookResourceModel(&plan, workbook)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workbookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state workbookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete workbook
+	err := r.client.DeleteWorkbook(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// If the workbook is already deleted, we can ignore the error
+			resp.Diagnostics.AddWarning(
+				"Unable to Delete Tableau Workbook",
+				err.Error(),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Tableau Workbook",
+				err.Error(),
+			)
+		}
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workbookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.TableauClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.TableauClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *workbookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandWorkbook returns the workbook described by the model, as published.
+func (r *workbookResource) expandWorkbook(model workbookResourceModel) client.Workbook {
+	return client.Workbook{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ShowTabs:    client.FlexBool(model.ShowTabs.ValueBool()),
+		Project:     &client.ContentProject{ID: model.ProjectID.ValueString()},
+	}
+}
+
+// publisher publishes and tags workbooks.
+func (r *workbookResource) publisher() contentPublisher[*client.Workbook] {
+	return contentPublisher[*client.Workbook]{
+		client:      r.client,
+		contentType: client.ContentTypeWorkbooks,
+		get:         r.client.GetWorkbook,
+		id:          func(workbook *client.Workbook) string { return workbook.ID },
+	}
+}
+
+func setWorkbookResourceModel(model *workbookResourceModel, workbook *client.Workbook) {
+	model.ID = types.StringValue(workbook.ID)
+	model.Name = types.StringValue(workbook.Name)
+	model.ProjectID = types.StringValue(workbook.ProjectID())
+	model.Description = types.StringValue(workbook.Description)
+	model.ShowTabs = types.BoolValue(bool(workbook.ShowTabs))
+	model.Tags = tagsValue(workbook.TagLabels())
+	model.ContentUrl = types.StringValue(workbook.ContentUrl)
+	model.WebpageUrl = types.StringValue(workbook.WebpageUrl)
+}
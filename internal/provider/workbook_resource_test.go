@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccWorkbook is a workbook with a single empty sheet.
+const testAccWorkbook = `<?xml version='1.0' encoding='utf-8' ?>
+<workbook version='18.1' xmlns:user='http://www.tableausoftware.com/xml/user'>
+  <worksheets>
+    <worksheet name='%s'>
+      <table>
+        <view>
+          <datasources />
+        </view>
+        <style />
+        <panes>
+          <pane>
+            <mark class='Automatic' />
+          </pane>
+        </panes>
+        <rows />
+        <cols />
+      </table>
+    </worksheet>
+  </worksheets>
+</workbook>
+`
+
+func writeTestAccWorkbook(t *testing.T, sheetName string) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), "workbook.twb")
+	err := os.WriteFile(filePath, []byte(fmt.Sprintf(testAccWorkbook, sheetName)), 0o600)
+	if err != nil {
+		t.Fatalf("writing workbook file: %s", err)
+	}
+
+	return filePath
+}
+
+func TestAccWorkbookResource(t *testing.T) {
+	filePath := writeTestAccWorkbook(t, "Sheet 1")
+	updatedFilePath := writeTestAccWorkbook(t, "Sheet 2")
+
+	// Test cases for workbook resource
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-workbooks"
+}
+
+resource "tableau_workbook" "uat_terraform_provider_test" {
+	name        = "uat-terraform-provider-test"
+	project_id  = tableau_project.uat_terraform_provider_test.id
+	file_path   = %q
+	description = "UAT - terraform provider test"
+	tags        = ["uat", "terraform"]
+}
+`, filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "name", "uat-terraform-provider-test"),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "description", "UAT - terraform provider test"),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "show_tabs", "false"),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "tags.#", "2"),
+					resource.TestCheckResourceAttrPair("tableau_workbook.uat_terraform_provider_test", "project_id", "tableau_project.uat_terraform_provider_test", "id"),
+					// Verify dynamic values have any value set in the state.
+					resource.TestCheckResourceAttrSet("tableau_workbook.uat_terraform_provider_test", "id"),
+					resource.TestCheckResourceAttrSet("tableau_workbook.uat_terraform_provider_test", "content_hash"),
+					resource.TestCheckResourceAttrSet("tableau_workbook.uat_terraform_provider_test", "content_url"),
+					resource.TestCheckResourceAttrSet("tableau_workbook.uat_terraform_provider_test", "webpage_url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "tableau_workbook.uat_terraform_provider_test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file_path", "content_hash", "overwrite"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tableau_project" "uat_terraform_provider_test" {
+	name = "uat-terraform-provider-test-workbooks"
+}
+
+resource "tableau_workbook" "uat_terraform_provider_test" {
+	name       = "uat-terraform-provider-test-updated"
+	project_id = tableau_project.uat_terraform_provider_test.id
+	file_path  = %q
+	show_tabs  = true
+	tags       = ["uat"]
+}
+`, updatedFilePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "name", "uat-terraform-provider-test-updated"),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "description", ""),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "show_tabs", "true"),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("tableau_workbook.uat_terraform_provider_test", "tags.0", "uat"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -1,5 +1,7 @@
 package utils
 
+import "strings"
+
 func StringInSlice(a string, list []string) bool {
 	for _, b := range list {
 		if b == a {
@@ -8,3 +10,12 @@ func StringInSlice(a string, list []string) bool {
 	}
 	return false
 }
+
+func StringInSliceFold(a string, list []string) bool {
+	for _, b := range list {
+		if strings.EqualFold(b, a) {
+			return true
+		}
+	}
+	return false
+}